@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -22,12 +23,12 @@ type AgeRating struct {
 // AgeRatingCategory specifies a regulatory organization.
 type AgeRatingCategory int
 
-//go:generate stringer -type=AgeRatingCategory,AgeRatingEnum
+//go:generate stringer -type=AgeRatingCategory,AgeRatingEnum -linecomment
 
 // Expected AgeRatingCategory enums from the IGDB.
 const (
-	AgeRatingESRB AgeRatingCategory = iota + 1
-	AgeRatingPEGI
+	AgeRatingESRB AgeRatingCategory = iota + 1 // ESRB
+	AgeRatingPEGI                              // PEGI
 )
 
 // AgeRatingEnum specifies a specific age rating.
@@ -35,18 +36,18 @@ type AgeRatingEnum int
 
 // Expected AgeRatingEnum enums from the IGDB.
 const (
-	AgeRatingThree AgeRatingEnum = iota + 1
-	AgeRatingSeven
-	AgeRatingTwelve
-	AgeRatingSixteen
-	AgeRatingEighteen
-	AgeRatingRP
-	AgeRatingEC
-	AgeRatingE
-	AgeRatingE10
-	AgeRatingT
-	AgeRatingM
-	AgeRatingAO
+	AgeRatingThree    AgeRatingEnum = iota + 1 // PEGI 3
+	AgeRatingSeven                             // PEGI 7
+	AgeRatingTwelve                            // PEGI 12
+	AgeRatingSixteen                           // PEGI 16
+	AgeRatingEighteen                          // PEGI 18
+	AgeRatingRP                                // ESRB Rating Pending
+	AgeRatingEC                                // ESRB Early Childhood
+	AgeRatingE                                 // ESRB Everyone
+	AgeRatingE10                               // ESRB Everyone 10+
+	AgeRatingT                                 // ESRB Teen
+	AgeRatingM                                 // ESRB Mature
+	AgeRatingAO                                // ESRB Adults Only
 )
 
 // AgeRatingService handles all the API calls for the IGDB AgeRating endpoint.
@@ -56,14 +57,23 @@ type AgeRatingService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any AgeRatings, an error is returned.
 func (as *AgeRatingService) Get(id int, opts ...Option) (*AgeRating, error) {
+	return as.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single AgeRating identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any AgeRatings, an error
+// is returned.
+func (as *AgeRatingService) GetWithContext(ctx context.Context, id int, opts ...Option) (*AgeRating, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var age []*AgeRating
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := as.client.post(as.end, &age, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.postWithContext(ctx, as.end, &age, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AgeRating with ID %v", id)
 	}
@@ -76,6 +86,15 @@ func (as *AgeRatingService) Get(id int, opts ...Option) (*AgeRating, error) {
 // Any ID that does not match a AgeRating is ignored. If none of the IDs
 // match a AgeRating, an error is returned.
 func (as *AgeRatingService) List(ids []int, opts ...Option) ([]*AgeRating, error) {
+	return as.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of AgeRatings identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a AgeRating is ignored. If
+// none of the IDs match a AgeRating, an error is returned.
+func (as *AgeRatingService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*AgeRating, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -89,7 +108,7 @@ func (as *AgeRatingService) List(ids []int, opts ...Option) ([]*AgeRating, error
 	var age []*AgeRating
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := as.client.post(as.end, &age, opts...)
+	err := as.client.postWithContext(ctx, as.end, &age, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AgeRatings with IDs %v", ids)
 	}
@@ -101,9 +120,18 @@ func (as *AgeRatingService) List(ids []int, opts ...Option) ([]*AgeRating, error
 // options used to sort, filter, and paginate the results. If no AgeRatings can
 // be found using the provided options, an error is returned.
 func (as *AgeRatingService) Index(opts ...Option) ([]*AgeRating, error) {
+	return as.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of AgeRatings based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no AgeRatings can be found using the provided options, an error
+// is returned.
+func (as *AgeRatingService) IndexWithContext(ctx context.Context, opts ...Option) ([]*AgeRating, error) {
 	var age []*AgeRating
 
-	err := as.client.post(as.end, &age, opts...)
+	err := as.client.postWithContext(ctx, as.end, &age, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of AgeRatings")
 	}
@@ -115,7 +143,15 @@ func (as *AgeRatingService) Index(opts ...Option) ([]*AgeRating, error) {
 // Provide the SetFilter functional option if you need to filter
 // which AgeRatings to count.
 func (as *AgeRatingService) Count(opts ...Option) (int, error) {
-	ct, err := as.client.getCount(as.end, opts...)
+	return as.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of AgeRatings available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// AgeRatings to count.
+func (as *AgeRatingService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := as.client.getCountWithContext(ctx, as.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count AgeRatings")
 	}
@@ -126,7 +162,14 @@ func (as *AgeRatingService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB AgeRating object.
 func (as *AgeRatingService) Fields() ([]string, error) {
-	f, err := as.client.getFields(as.end)
+	return as.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB AgeRating
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (as *AgeRatingService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := as.client.getFieldsWithContext(ctx, as.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get AgeRating fields")
 	}
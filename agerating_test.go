@@ -2,10 +2,12 @@ package igdb
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +51,7 @@ func TestAgeRatingService_Get(t *testing.T) {
 			defer ts.Close()
 
 			age, err := c.AgeRatings.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +98,7 @@ func TestAgeRatingService_List(t *testing.T) {
 			defer ts.Close()
 
 			age, err := c.AgeRatings.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +142,7 @@ func TestAgeRatingService_Index(t *testing.T) {
 			defer ts.Close()
 
 			age, err := c.AgeRatings.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +173,7 @@ func TestAgeRatingService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.AgeRatings.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +204,7 @@ func TestAgeRatingService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.AgeRatings.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -212,3 +214,57 @@ func TestAgeRatingService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestAgeRating_String(t *testing.T) {
+	var tests = []struct {
+		name    string
+		str     fmt.Stringer
+		wantStr string
+	}{
+		{"ESRB category", AgeRatingESRB, "ESRB"},
+		{"PEGI category", AgeRatingPEGI, "PEGI"},
+		{"Unknown category", AgeRatingCategory(0), "AgeRatingCategory(0)"},
+		{"PEGI rating", AgeRatingEighteen, "PEGI 18"},
+		{"ESRB rating", AgeRatingM, "ESRB Mature"},
+		{"ESRB plus rating", AgeRatingE10, "ESRB Everyone 10+"},
+		{"Unknown rating", AgeRatingEnum(99), "AgeRatingEnum(99)"},
+		{"Negative rating", AgeRatingEnum(-1), "AgeRatingEnum(-1)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.str.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.str.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestAgeRating_RoundTrip(t *testing.T) {
+	age := &AgeRating{ID: 40, Category: AgeRatingPEGI, Rating: AgeRatingSixteen}
+
+	b, err := json.Marshal(age)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"category":2`
+	if !strings.Contains(string(b), want) {
+		t.Errorf("got: <%v>, want: <%v>", string(b), want)
+	}
+
+	want = `"rating":4`
+	if !strings.Contains(string(b), want) {
+		t.Errorf("got: <%v>, want: <%v>", string(b), want)
+	}
+
+	got := &AgeRating{}
+	err = json.Unmarshal(b, got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, age) {
+		t.Errorf("got: <%v>, \nwant: <%v>", got, age)
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -18,12 +19,12 @@ type AgeRatingContent struct {
 // AgeRatingContentCategory specifies a regulatory organization.
 type AgeRatingContentCategory int
 
-//go:generate stringer -type=AgeRatingContentCategory
+//go:generate stringer -type=AgeRatingContentCategory -linecomment
 
 // Expected AgeRatingContentCategory enums from the IGDB.
 const (
-	AgeRatingContentPEGI AgeRatingContentCategory = iota + 1
-	AgeRatingContentESRB
+	AgeRatingContentPEGI AgeRatingContentCategory = iota + 1 // PEGI
+	AgeRatingContentESRB                                     // ESRB
 )
 
 // AgeRatingContentService handles all the API calls for the IGDB AgeRatingContent endpoint.
@@ -33,14 +34,23 @@ type AgeRatingContentService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any AgeRatingContents, an error is returned.
 func (as *AgeRatingContentService) Get(id int, opts ...Option) (*AgeRatingContent, error) {
+	return as.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single AgeRatingContent identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// AgeRatingContents, an error is returned.
+func (as *AgeRatingContentService) GetWithContext(ctx context.Context, id int, opts ...Option) (*AgeRatingContent, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var cont []*AgeRatingContent
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := as.client.post(as.end, &cont, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.postWithContext(ctx, as.end, &cont, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AgeRatingContent with ID %v", id)
 	}
@@ -53,6 +63,16 @@ func (as *AgeRatingContentService) Get(id int, opts ...Option) (*AgeRatingConten
 // Any ID that does not match a AgeRatingContent is ignored. If none of the IDs
 // match a AgeRatingContent, an error is returned.
 func (as *AgeRatingContentService) List(ids []int, opts ...Option) ([]*AgeRatingContent, error) {
+	return as.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of AgeRatingContents identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// AgeRatingContent is ignored. If none of the IDs match a AgeRatingContent, an
+// error is returned.
+func (as *AgeRatingContentService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*AgeRatingContent, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -66,7 +86,7 @@ func (as *AgeRatingContentService) List(ids []int, opts ...Option) ([]*AgeRating
 	var cont []*AgeRatingContent
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := as.client.post(as.end, &cont, opts...)
+	err := as.client.postWithContext(ctx, as.end, &cont, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AgeRatingContents with IDs %v", ids)
 	}
@@ -78,9 +98,18 @@ func (as *AgeRatingContentService) List(ids []int, opts ...Option) ([]*AgeRating
 // options used to sort, filter, and paginate the results. If no AgeRatingContents can
 // be found using the provided options, an error is returned.
 func (as *AgeRatingContentService) Index(opts ...Option) ([]*AgeRatingContent, error) {
+	return as.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of AgeRatingContents based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no AgeRatingContents can be found using the provided options,
+// an error is returned.
+func (as *AgeRatingContentService) IndexWithContext(ctx context.Context, opts ...Option) ([]*AgeRatingContent, error) {
 	var cont []*AgeRatingContent
 
-	err := as.client.post(as.end, &cont, opts...)
+	err := as.client.postWithContext(ctx, as.end, &cont, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of AgeRatingContents")
 	}
@@ -92,7 +121,15 @@ func (as *AgeRatingContentService) Index(opts ...Option) ([]*AgeRatingContent, e
 // Provide the SetFilter functional option if you need to filter
 // which AgeRatingContents to count.
 func (as *AgeRatingContentService) Count(opts ...Option) (int, error) {
-	ct, err := as.client.getCount(as.end, opts...)
+	return as.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of AgeRatingContents available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which AgeRatingContents to count.
+func (as *AgeRatingContentService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := as.client.getCountWithContext(ctx, as.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count AgeRatingContents")
 	}
@@ -103,7 +140,14 @@ func (as *AgeRatingContentService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB AgeRatingContent object.
 func (as *AgeRatingContentService) Fields() ([]string, error) {
-	f, err := as.client.getFields(as.end)
+	return as.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// AgeRatingContent object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (as *AgeRatingContentService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := as.client.getFieldsWithContext(ctx, as.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get AgeRatingContent fields")
 	}
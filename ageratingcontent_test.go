@@ -49,7 +49,7 @@ func TestAgeRatingContentService_Get(t *testing.T) {
 			defer ts.Close()
 
 			cont, err := c.AgeRatingContents.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestAgeRatingContentService_List(t *testing.T) {
 			defer ts.Close()
 
 			cont, err := c.AgeRatingContents.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestAgeRatingContentService_Index(t *testing.T) {
 			defer ts.Close()
 
 			cont, err := c.AgeRatingContents.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestAgeRatingContentService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.AgeRatingContents.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +201,7 @@ func TestAgeRatingContentService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.AgeRatingContents.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
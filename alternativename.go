@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -25,14 +26,23 @@ type AlternativeNameService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any AlternativeNames, an error is returned.
 func (as *AlternativeNameService) Get(id int, opts ...Option) (*AlternativeName, error) {
+	return as.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single AlternativeName identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// AlternativeNames, an error is returned.
+func (as *AlternativeNameService) GetWithContext(ctx context.Context, id int, opts ...Option) (*AlternativeName, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var alt []*AlternativeName
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := as.client.post(as.end, &alt, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.postWithContext(ctx, as.end, &alt, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AlternativeName with ID %v", id)
 	}
@@ -45,6 +55,15 @@ func (as *AlternativeNameService) Get(id int, opts ...Option) (*AlternativeName,
 // Any ID that does not match a AlternativeName is ignored. If none of the IDs
 // match a AlternativeName, an error is returned.
 func (as *AlternativeNameService) List(ids []int, opts ...Option) ([]*AlternativeName, error) {
+	return as.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of AlternativeNames identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a AlternativeName is
+// ignored. If none of the IDs match a AlternativeName, an error is returned.
+func (as *AlternativeNameService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*AlternativeName, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -58,7 +77,7 @@ func (as *AlternativeNameService) List(ids []int, opts ...Option) ([]*Alternativ
 	var alt []*AlternativeName
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := as.client.post(as.end, &alt, opts...)
+	err := as.client.postWithContext(ctx, as.end, &alt, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get AlternativeNames with IDs %v", ids)
 	}
@@ -70,9 +89,18 @@ func (as *AlternativeNameService) List(ids []int, opts ...Option) ([]*Alternativ
 // options used to sort, filter, and paginate the results. If no AlternativeNames can
 // be found using the provided options, an error is returned.
 func (as *AlternativeNameService) Index(opts ...Option) ([]*AlternativeName, error) {
+	return as.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of AlternativeNames based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no AlternativeNames can be found using the provided options,
+// an error is returned.
+func (as *AlternativeNameService) IndexWithContext(ctx context.Context, opts ...Option) ([]*AlternativeName, error) {
 	var alt []*AlternativeName
 
-	err := as.client.post(as.end, &alt, opts...)
+	err := as.client.postWithContext(ctx, as.end, &alt, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of AlternativeNames")
 	}
@@ -84,7 +112,15 @@ func (as *AlternativeNameService) Index(opts ...Option) ([]*AlternativeName, err
 // Provide the SetFilter functional option if you need to filter
 // which AlternativeNames to count.
 func (as *AlternativeNameService) Count(opts ...Option) (int, error) {
-	ct, err := as.client.getCount(as.end, opts...)
+	return as.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of AlternativeNames available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which AlternativeNames to count.
+func (as *AlternativeNameService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := as.client.getCountWithContext(ctx, as.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count AlternativeNames")
 	}
@@ -95,7 +131,14 @@ func (as *AlternativeNameService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB AlternativeName object.
 func (as *AlternativeNameService) Fields() ([]string, error) {
-	f, err := as.client.getFields(as.end)
+	return as.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// AlternativeName object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (as *AlternativeNameService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := as.client.getFieldsWithContext(ctx, as.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get AlternativeName fields")
 	}
@@ -49,7 +49,7 @@ func TestAlternativeNameService_Get(t *testing.T) {
 			defer ts.Close()
 
 			alt, err := c.AlternativeNames.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestAlternativeNameService_List(t *testing.T) {
 			defer ts.Close()
 
 			alt, err := c.AlternativeNames.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestAlternativeNameService_Index(t *testing.T) {
 			defer ts.Close()
 
 			alt, err := c.AlternativeNames.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestAlternativeNameService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.AlternativeNames.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +201,7 @@ func TestAlternativeNameService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.AlternativeNames.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -22,14 +23,23 @@ type Artwork struct {
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Artworks, an error is returned.
 func (as *ArtworkService) Get(id int, opts ...Option) (*Artwork, error) {
+	return as.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Artwork identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Artworks, an error is
+// returned.
+func (as *ArtworkService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Artwork, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var art []*Artwork
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := as.client.post(as.end, &art, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.postWithContext(ctx, as.end, &art, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Artwork with ID %v", id)
 	}
@@ -42,6 +52,15 @@ func (as *ArtworkService) Get(id int, opts ...Option) (*Artwork, error) {
 // Any ID that does not match a Artwork is ignored. If none of the IDs
 // match a Artwork, an error is returned.
 func (as *ArtworkService) List(ids []int, opts ...Option) ([]*Artwork, error) {
+	return as.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Artworks identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Artwork is ignored. If
+// none of the IDs match a Artwork, an error is returned.
+func (as *ArtworkService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Artwork, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -55,7 +74,7 @@ func (as *ArtworkService) List(ids []int, opts ...Option) ([]*Artwork, error) {
 	var art []*Artwork
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := as.client.post(as.end, &art, opts...)
+	err := as.client.postWithContext(ctx, as.end, &art, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Artworks with IDs %v", ids)
 	}
@@ -63,13 +82,43 @@ func (as *ArtworkService) List(ids []int, opts ...Option) ([]*Artwork, error) {
 	return art, nil
 }
 
+// ListByGame returns the list of Artworks belonging to the Game identified by
+// the provided IGDB ID. Up to 500 Artworks are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no Artworks, an error is returned.
+func (as *ArtworkService) ListByGame(gameID int, opts ...Option) ([]*Artwork, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var art []*Artwork
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := as.client.post(as.end, &art, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Artworks with Game ID %v", gameID)
+	}
+
+	return art, nil
+}
+
 // Index returns an index of Artworks based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no Artworks can
 // be found using the provided options, an error is returned.
 func (as *ArtworkService) Index(opts ...Option) ([]*Artwork, error) {
+	return as.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Artworks based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Artworks can be found using the provided options, an error is
+// returned.
+func (as *ArtworkService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Artwork, error) {
 	var art []*Artwork
 
-	err := as.client.post(as.end, &art, opts...)
+	err := as.client.postWithContext(ctx, as.end, &art, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Artworks")
 	}
@@ -81,7 +130,15 @@ func (as *ArtworkService) Index(opts ...Option) ([]*Artwork, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Artworks to count.
 func (as *ArtworkService) Count(opts ...Option) (int, error) {
-	ct, err := as.client.getCount(as.end, opts...)
+	return as.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Artworks available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Artworks to count.
+func (as *ArtworkService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := as.client.getCountWithContext(ctx, as.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Artworks")
 	}
@@ -92,7 +149,14 @@ func (as *ArtworkService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Artwork object.
 func (as *ArtworkService) Fields() ([]string, error) {
-	f, err := as.client.getFields(as.end)
+	return as.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Artwork
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (as *ArtworkService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := as.client.getFieldsWithContext(ctx, as.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Artwork fields")
 	}
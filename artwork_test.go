@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestArtworkService_Get(t *testing.T) {
 			defer ts.Close()
 
 			art, err := c.Artworks.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestArtworkService_List(t *testing.T) {
 			defer ts.Close()
 
 			art, err := c.Artworks.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +108,68 @@ func TestArtworkService_List(t *testing.T) {
 	}
 }
 
+func TestArtworkService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testArtworkList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Artwork, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name         string
+		file         string
+		id           int
+		opts         []Option
+		wantLim      string
+		wantArtworks []*Artwork
+		wantErr      error
+	}{
+		{"Valid response", testArtworkList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testArtworkList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			art, err := c.Artworks.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(art, test.wantArtworks) {
+				t.Errorf("got: <%v>, \nwant: <%v>", art, test.wantArtworks)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
 func TestArtworkService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testArtworkList)
 	if err != nil {
@@ -140,7 +203,7 @@ func TestArtworkService_Index(t *testing.T) {
 			defer ts.Close()
 
 			art, err := c.Artworks.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +234,7 @@ func TestArtworkService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Artworks.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +264,7 @@ func TestArtworkService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Artworks.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -0,0 +1,266 @@
+package igdb
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"sync"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// Cache stores the bodies of successful API responses. Implementations must
+// be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under the provided key and true,
+	// or false if no unexpired value is stored under the key.
+	Get(key string) ([]byte, bool)
+	// Set stores the provided value under the provided key until the
+	// provided time-to-live has elapsed.
+	Set(key string, val []byte, ttl time.Duration)
+}
+
+// SetCache configures the Client to store the results of its queries in the
+// provided Cache for the provided time-to-live. Later queries for the same
+// endpoint with the same options are answered from the Cache instead of the
+// IGDB until the results expire. This is best suited to endpoints that rarely
+// change, such as genres, platforms, and themes. A Response returned for
+// cached results only carries the count and query metadata headers of the
+// original response. A nil Cache or non-positive time-to-live
+// disables caching, which is the default. SetCache should be called before the
+// Client is used.
+func (c *Client) SetCache(cache Cache, ttl time.Duration) {
+	if cache == nil || ttl <= 0 {
+		c.cache = nil
+		c.cacheTTL = 0
+		return
+	}
+
+	c.cache = cache
+	c.cacheTTL = ttl
+}
+
+// clauseSkipCache is the query clause set by SkipCache. It is
+// never written to the body of a request.
+const clauseSkipCache = "skip_cache"
+
+// SkipCache is a functional option used to bypass the Client's Cache for a
+// single API call. The results are retrieved from the IGDB and neither read
+// from nor stored in the Cache.
+func SkipCache() Option {
+	return func() (apicalypse.Option, error) {
+		return func(filters map[string]string) error {
+			filters[clauseSkipCache] = "true"
+			return nil
+		}, nil
+	}
+}
+
+// skipsCache returns true if any of the provided options is SkipCache.
+func skipsCache(opts []Option) bool {
+	for _, opt := range opts {
+		if _, ok := optionClauses(opt)[clauseSkipCache]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sendCached stores the results of the provided request in the value pointed
+// to by result, answering the request from the Client's Cache when possible.
+// Results retrieved from the IGDB are stored in the Cache, including empty
+// results, while error responses are not.
+func (c *Client) sendCached(req *http.Request, result interface{}) (*Response, error) {
+	key, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry, ok := c.cache.Get(key); ok {
+		h, b, err := decodeEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		trace := traceFrom(req.Context())
+		trace.cached = true
+		trace.size = int64(len(b))
+
+		r := &Response{
+			Status:             http.StatusOK,
+			Count:              headerIntDefault(h, headerCount, -1),
+			RateLimit:          -1,
+			RateLimitRemaining: -1,
+			Header:             h,
+		}
+		return r, decodeResults(bytes.NewReader(b), result)
+	}
+
+	r, b, err := c.fetch(req)
+	if err != nil {
+		return r, err
+	}
+
+	err = decodeResults(bytes.NewReader(b), result)
+	if err == nil || err == ErrNoResults {
+		c.cache.Set(key, encodeEntry(r.Header, b), c.cacheTTL)
+	}
+
+	return r, err
+}
+
+// cachedHeaders are the response headers stored in the Cache alongside the
+// body of a response.
+var cachedHeaders = []string{headerTotalCount, headerCount, headerExecutionTime}
+
+// encodeEntry returns the Cache entry for a response with the provided
+// headers and body. The cachedHeaders are written in MIME header format,
+// followed by a blank line and the body.
+func encodeEntry(h http.Header, body []byte) []byte {
+	var buf bytes.Buffer
+	for _, k := range cachedHeaders {
+		if v := h.Get(k); v != "" {
+			buf.WriteString(k + ": " + v + "\r\n")
+		}
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body)
+
+	return buf.Bytes()
+}
+
+// decodeEntry returns the headers and body of the provided Cache entry.
+func decodeEntry(entry []byte) (http.Header, []byte, error) {
+	br := bufio.NewReader(bytes.NewReader(entry))
+
+	h, err := textproto.NewReader(br).ReadMIMEHeader()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot read cached headers")
+	}
+
+	body, err := ioutil.ReadAll(br)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot read cached body")
+	}
+
+	return http.Header(h), body, nil
+}
+
+// cacheKey returns the key identifying the results of the provided request,
+// made up of its URL and its fully composed query.
+func cacheKey(req *http.Request) (string, error) {
+	if req.GetBody == nil {
+		return req.URL.String(), nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", errors.Wrap(err, "cannot read request body")
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot read request body")
+	}
+
+	return req.URL.String() + "\n" + string(b), nil
+}
+
+// LRUCache is an in-memory Cache holding a fixed number of values. When the
+// LRUCache is full, the least recently used value is evicted to make room
+// for a new one. LRUCache is safe for concurrent use.
+type LRUCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+// lruEntry is a value stored in an LRUCache.
+type lruEntry struct {
+	key     string
+	val     []byte
+	expires time.Time
+}
+
+// NewLRUCache returns a new LRUCache holding at most the provided number of
+// values. A non-positive size is treated as a size of one.
+func NewLRUCache(size int) *LRUCache {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &LRUCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+		now:   time.Now,
+	}
+}
+
+// Get returns the value stored under the provided key and true, or false if
+// no unexpired value is stored under the key. Expired values are removed.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*lruEntry)
+	if !l.now().Before(e.expires) {
+		l.remove(el)
+		return nil, false
+	}
+
+	l.ll.MoveToFront(el)
+	return e.val, true
+}
+
+// Set stores the provided value under the provided key until the provided
+// time-to-live has elapsed, evicting the least recently used value if the
+// LRUCache is full.
+func (l *LRUCache) Set(key string, val []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expires := l.now().Add(ttl)
+
+	if el, ok := l.items[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.val, e.expires = val, expires
+		l.ll.MoveToFront(el)
+		return
+	}
+
+	l.items[key] = l.ll.PushFront(&lruEntry{key: key, val: val, expires: expires})
+
+	if l.ll.Len() > l.size {
+		l.remove(l.ll.Back())
+	}
+}
+
+// Len returns the number of values stored in the LRUCache,
+// including any that have expired but not yet been removed.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.ll.Len()
+}
+
+// remove removes the provided element from the LRUCache.
+func (l *LRUCache) remove(el *list.Element) {
+	l.ll.Remove(el)
+	delete(l.items, el.Value.(*lruEntry).key)
+}
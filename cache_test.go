@@ -0,0 +1,139 @@
+package igdb
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestLRUCache(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := NewLRUCache(2)
+	l.now = func() time.Time { return now }
+
+	l.Set("a", []byte("1"), time.Minute)
+	l.Set("b", []byte("2"), time.Minute)
+
+	if got, ok := l.Get("a"); !ok || string(got) != "1" {
+		t.Errorf("got: <%s, %v>, want: <1, true>", got, ok)
+	}
+
+	// "b" is now the least recently used value and is evicted.
+	l.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := l.Get("b"); ok {
+		t.Errorf("got: <true>, want evicted value to be missing")
+	}
+
+	if got := l.Len(); got != 2 {
+		t.Errorf("got: <%v>, want: <%v>", got, 2)
+	}
+
+	l.Set("a", []byte("4"), time.Second)
+	if got, ok := l.Get("a"); !ok || string(got) != "4" {
+		t.Errorf("got: <%s, %v>, want: <4, true>", got, ok)
+	}
+
+	now = now.Add(time.Second)
+
+	if _, ok := l.Get("a"); ok {
+		t.Errorf("got: <true>, want expired value to be missing")
+	}
+
+	if got, ok := l.Get("c"); !ok || string(got) != "3" {
+		t.Errorf("got: <%s, %v>, want: <3, true>", got, ok)
+	}
+}
+
+func TestLRUCache_Concurrent(t *testing.T) {
+	l := NewLRUCache(8)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := string(rune('a' + i%10))
+			for j := 0; j < 100; j++ {
+				l.Set(key, []byte(key), time.Minute)
+				l.Get(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := l.Len(); got > 8 {
+		t.Errorf("got: <%v>, want at most: <%v>", got, 8)
+	}
+}
+
+func TestClient_SetCache(t *testing.T) {
+	f, err := ioutil.ReadFile(testGenreList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		status    int
+		cache     bool
+		first     []Option
+		second    []Option
+		wantCalls int32
+		wantErr   error
+	}{
+		{"Cache disabled", http.StatusOK, false, nil, nil, 2, nil},
+		{"Same options", http.StatusOK, true, []Option{SetLimit(5)}, []Option{SetLimit(5)}, 1, nil},
+		{"Different options", http.StatusOK, true, []Option{SetLimit(5)}, []Option{SetLimit(6)}, 2, nil},
+		{"Skip cache", http.StatusOK, true, nil, []Option{SkipCache()}, 2, nil},
+		{"Error response", http.StatusBadRequest, true, nil, nil, 2, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				if strings.Contains(string(b), clauseSkipCache) {
+					t.Errorf("got body: <%s>, want body without %s clause", b, clauseSkipCache)
+				}
+
+				w.WriteHeader(test.status)
+				w.Write(f)
+			})
+			defer ts.Close()
+
+			if test.cache {
+				c.SetCache(NewLRUCache(10), time.Minute)
+			}
+
+			first, err := c.Genres.Index(test.first...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			second, err := c.Genres.Index(test.second...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if len(first) != len(second) {
+				t.Errorf("got: <%v> results, want: <%v> results", len(second), len(first))
+			}
+
+			if got := atomic.LoadInt32(&calls); got != test.wantCalls {
+				t.Errorf("got: <%v> requests, want: <%v> requests", got, test.wantCalls)
+			}
+		})
+	}
+}
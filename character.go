@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,10 +12,10 @@ import (
 // Character represents a video game character.
 // For more information visit: https://api-docs.igdb.com/#character
 type Character struct {
-	ID          int              `json:"ID"`
+	ID          int              `json:"id"`
 	AKAS        []string         `json:"akas"`
 	CountryName string           `json:"country_name"`
-	CreatedAt   int              `json:"created_at"`
+	CreatedAt   Timestamp        `json:"created_at"`
 	Description string           `json:"description"`
 	Games       []int            `json:"games"`
 	Gender      CharacterGender  `json:"gender"`
@@ -23,7 +24,7 @@ type Character struct {
 	People      []int            `json:"people"`
 	Slug        string           `json:"slug"`
 	Species     CharacterSpecies `json:"species"`
-	UpdatedAt   int              `json:"updated_at"`
+	UpdatedAt   Timestamp        `json:"updated_at"`
 	URL         string           `json:"url"`
 }
 
@@ -58,14 +59,23 @@ type CharacterService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Characters, an error is returned.
 func (cs *CharacterService) Get(id int, opts ...Option) (*Character, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Character identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Characters, an error
+// is returned.
+func (cs *CharacterService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Character, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var ch []*Character
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &ch, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &ch, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Character with ID %v", id)
 	}
@@ -78,6 +88,15 @@ func (cs *CharacterService) Get(id int, opts ...Option) (*Character, error) {
 // Any ID that does not match a Character is ignored. If none of the IDs
 // match a Character, an error is returned.
 func (cs *CharacterService) List(ids []int, opts ...Option) ([]*Character, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Characters identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Character is ignored. If
+// none of the IDs match a Character, an error is returned.
+func (cs *CharacterService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Character, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -91,7 +110,7 @@ func (cs *CharacterService) List(ids []int, opts ...Option) ([]*Character, error
 	var ch []*Character
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &ch, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &ch, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Characters with IDs %v", ids)
 	}
@@ -103,9 +122,18 @@ func (cs *CharacterService) List(ids []int, opts ...Option) ([]*Character, error
 // options used to sort, filter, and paginate the results. If no Characters can
 // be found using the provided options, an error is returned.
 func (cs *CharacterService) Index(opts ...Option) ([]*Character, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Characters based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Characters can be found using the provided options, an error
+// is returned.
+func (cs *CharacterService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Character, error) {
 	var ch []*Character
 
-	err := cs.client.post(cs.end, &ch, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &ch, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Characters")
 	}
@@ -117,10 +145,19 @@ func (cs *CharacterService) Index(opts ...Option) ([]*Character, error) {
 // query. Provide functional options to sort, filter, and paginate the results. If
 // no Characters are found using the provided query, an error is returned.
 func (cs *CharacterService) Search(qry string, opts ...Option) ([]*Character, error) {
+	return cs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Characters found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Characters are found using the
+// provided query, an error is returned.
+func (cs *CharacterService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Character, error) {
 	var ch []*Character
 
 	opts = append(opts, setSearch(qry))
-	err := cs.client.post(cs.end, &ch, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &ch, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Character with query %s", qry)
 	}
@@ -128,11 +165,39 @@ func (cs *CharacterService) Search(qry string, opts ...Option) ([]*Character, er
 	return ch, nil
 }
 
+// GetMugshot returns the CharacterMugshot of the Character identified by the
+// provided IGDB ID. The Character is retrieved first to find its mug shot,
+// then the CharacterMugshot is retrieved in a second request. The image ID of
+// the returned CharacterMugshot can be used to build its image URL. Provide the
+// SetFields functional option if you need to specify which fields of the
+// CharacterMugshot to retrieve. If the Character has no mug shot, ErrNoResults
+// is returned.
+func (cs *CharacterService) GetMugshot(characterID int, opts ...Option) (*CharacterMugshot, error) {
+	ch, err := cs.Get(characterID, SetFields("mug_shot"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CharacterMugshot of Character with ID %v", characterID)
+	}
+
+	if ch.MugShot == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "Character with ID %v has no mug shot", characterID)
+	}
+
+	return cs.client.CharacterMugshots.Get(ch.MugShot, opts...)
+}
+
 // Count returns the number of Characters available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Characters to count.
 func (cs *CharacterService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Characters available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Characters to count.
+func (cs *CharacterService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Characters")
 	}
@@ -143,7 +208,14 @@ func (cs *CharacterService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Character object.
 func (cs *CharacterService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Character
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (cs *CharacterService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Character fields")
 	}
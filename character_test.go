@@ -50,7 +50,7 @@ func TestCharacterService_Get(t *testing.T) {
 			defer ts.Close()
 
 			ch, err := c.Characters.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -97,7 +97,7 @@ func TestCharacterService_List(t *testing.T) {
 			defer ts.Close()
 
 			ch, err := c.Characters.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -141,7 +141,7 @@ func TestCharacterService_Index(t *testing.T) {
 			defer ts.Close()
 
 			ch, err := c.Characters.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -187,7 +187,7 @@ func TestCharacterService_Search(t *testing.T) {
 			defer ts.Close()
 
 			ch, err := c.Characters.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -198,6 +198,62 @@ func TestCharacterService_Search(t *testing.T) {
 	}
 }
 
+func TestCharacterService_GetMugshot(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterMugshotGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterMugshot, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		charRes string
+		mugFile string
+		id      int
+		opts    []Option
+		wantMug *CharacterMugshot
+		wantErr error
+	}{
+		{"Valid response", `[{"id": 12690, "mug_shot": 3600}]`, testCharacterMugshotGet, 12690, []Option{SetFields("image_id")}, init[0], nil},
+		{"Invalid ID", "", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"No mug shot", `[{"id": 12690}]`, testCharacterMugshotGet, 12690, nil, nil, ErrNoResults},
+		{"No character", "[]", testCharacterMugshotGet, 12690, nil, nil, ErrNoResults},
+		{"No mug shot record", `[{"id": 12690, "mug_shot": 3600}]`, testFileEmptyArray, 12690, nil, nil, ErrNoResults},
+		{"Invalid option", `[{"id": 12690, "mug_shot": 3600}]`, testCharacterMugshotGet, 12690, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/"+string(EndpointCharacter) {
+					w.Write([]byte(test.charRes))
+					return
+				}
+
+				b, err := ioutil.ReadFile(test.mugFile)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(b)
+			})
+			defer ts.Close()
+
+			mug, err := c.Characters.GetMugshot(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(mug, test.wantMug) {
+				t.Errorf("got: <%v>, \nwant: <%v>", mug, test.wantMug)
+			}
+		})
+	}
+}
+
 func TestCharacterService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -218,7 +274,7 @@ func TestCharacterService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Characters.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -248,7 +304,7 @@ func TestCharacterService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Characters.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
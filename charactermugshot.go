@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -20,14 +21,23 @@ type CharacterMugshot struct {
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any CharacterMugshots, an error is returned.
 func (cs *CharacterMugshotService) Get(id int, opts ...Option) (*CharacterMugshot, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single CharacterMugshot identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// CharacterMugshots, an error is returned.
+func (cs *CharacterMugshotService) GetWithContext(ctx context.Context, id int, opts ...Option) (*CharacterMugshot, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var mug []*CharacterMugshot
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &mug, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &mug, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CharacterMugshot with ID %v", id)
 	}
@@ -40,6 +50,16 @@ func (cs *CharacterMugshotService) Get(id int, opts ...Option) (*CharacterMugsho
 // Any ID that does not match a CharacterMugshot is ignored. If none of the IDs
 // match a CharacterMugshot, an error is returned.
 func (cs *CharacterMugshotService) List(ids []int, opts ...Option) ([]*CharacterMugshot, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of CharacterMugshots identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// CharacterMugshot is ignored. If none of the IDs match a CharacterMugshot, an
+// error is returned.
+func (cs *CharacterMugshotService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*CharacterMugshot, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -53,7 +73,7 @@ func (cs *CharacterMugshotService) List(ids []int, opts ...Option) ([]*Character
 	var mug []*CharacterMugshot
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &mug, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &mug, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CharacterMugshots with IDs %v", ids)
 	}
@@ -65,9 +85,18 @@ func (cs *CharacterMugshotService) List(ids []int, opts ...Option) ([]*Character
 // options used to sort, filter, and paginate the results. If no CharacterMugshots can
 // be found using the provided options, an error is returned.
 func (cs *CharacterMugshotService) Index(opts ...Option) ([]*CharacterMugshot, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of CharacterMugshots based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no CharacterMugshots can be found using the provided options,
+// an error is returned.
+func (cs *CharacterMugshotService) IndexWithContext(ctx context.Context, opts ...Option) ([]*CharacterMugshot, error) {
 	var mug []*CharacterMugshot
 
-	err := cs.client.post(cs.end, &mug, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &mug, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of CharacterMugshots")
 	}
@@ -79,7 +108,15 @@ func (cs *CharacterMugshotService) Index(opts ...Option) ([]*CharacterMugshot, e
 // Provide the SetFilter functional option if you need to filter
 // which CharacterMugshots to count.
 func (cs *CharacterMugshotService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of CharacterMugshots available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which CharacterMugshots to count.
+func (cs *CharacterMugshotService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count CharacterMugshots")
 	}
@@ -90,7 +127,14 @@ func (cs *CharacterMugshotService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB CharacterMugshot object.
 func (cs *CharacterMugshotService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// CharacterMugshot object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (cs *CharacterMugshotService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get CharacterMugshot fields")
 	}
@@ -49,7 +49,7 @@ func TestCharacterMugshotService_Get(t *testing.T) {
 			defer ts.Close()
 
 			mug, err := c.CharacterMugshots.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestCharacterMugshotService_List(t *testing.T) {
 			defer ts.Close()
 
 			mug, err := c.CharacterMugshots.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestCharacterMugshotService_Index(t *testing.T) {
 			defer ts.Close()
 
 			mug, err := c.CharacterMugshots.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestCharacterMugshotService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.CharacterMugshots.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +201,7 @@ func TestCharacterMugshotService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.CharacterMugshots.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// defaultListConcurrency is the default number of chunks of IDs a List
+// function requests at once, matching the number of requests the IGDB
+// allows each second.
+const defaultListConcurrency = RequestsPerSecond
+
+// SetListChunking configures how GameService.List retrieves large lists of
+// IDs. The IDs are split into chunks of at most the provided size, each
+// retrieved with a separate request, with at most the provided number of
+// requests in flight at once. A non-positive size or a size above the maximum
+// limit of 500 uses the maximum limit, which is the default. A non-positive
+// concurrency uses the default of 4, matching the IGDB rate limit. Chunking
+// only applies to GameService.List; the List functions of the other services
+// send every ID in a single request. SetListChunking should be called before
+// the Client is used.
+func (c *Client) SetListChunking(size, concurrency int) {
+	if size <= 0 || size > maxLimit {
+		size = maxLimit
+	}
+
+	if concurrency <= 0 {
+		concurrency = defaultListConcurrency
+	}
+
+	c.chunkSize = size
+	c.chunkConcurrency = concurrency
+}
+
+// chunkIDs returns the provided IDs split into chunks of at most the provided
+// size. Duplicate IDs are removed, keeping the first occurrence of each.
+func chunkIDs(ids []int, size int) [][]int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	var chunks [][]int
+	for len(unique) > size {
+		chunks = append(chunks, unique[:size:size])
+		unique = unique[size:]
+	}
+
+	return append(chunks, unique)
+}
+
+// forEachChunk splits the provided IDs into chunks using the Client's chunk
+// size and calls the provided function with each chunk, with at most the
+// Client's chunk concurrency of calls running at once. Chunks without
+// results are ignored. The first other error encountered cancels the
+// remaining calls and is returned.
+func (c *Client) forEachChunk(ctx context.Context, ids []int, fn func(ctx context.Context, chunk []int) error) error {
+	size, concurrency := c.chunkSize, c.chunkConcurrency
+	if size <= 0 {
+		size = maxLimit
+	}
+	if concurrency <= 0 {
+		concurrency = defaultListConcurrency
+	}
+
+	chunks := chunkIDs(ids, size)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	sem := make(chan struct{}, concurrency)
+	for _, chunk := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(chunk []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, chunk); err != nil && errors.Cause(err) != ErrNoResults {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(chunk)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
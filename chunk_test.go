@@ -0,0 +1,168 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestChunkIDs(t *testing.T) {
+	tests := []struct {
+		name       string
+		ids        []int
+		size       int
+		wantChunks [][]int
+	}{
+		{"Single chunk", []int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+		{"Exact chunks", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"Partial last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"Duplicate IDs", []int{1, 2, 1, 3, 2, 4}, 3, [][]int{{1, 2, 3}, {4}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := chunkIDs(test.ids, test.size)
+			if !reflect.DeepEqual(got, test.wantChunks) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantChunks)
+			}
+		})
+	}
+}
+
+// testChunkServer returns a test server that responds to each request with
+// a Game for every requested ID that is found in the provided known IDs. The
+// requested limit must cover every requested ID. Requests for the provided
+// failing ID respond with a Bad Request status.
+func testChunkServer(t *testing.T, known []int, failID int, reqs *int32) http.HandlerFunc {
+	where := regexp.MustCompile(`where id = \(([0-9,]+)\)`)
+	limit := regexp.MustCompile(`limit ([0-9]+)`)
+
+	isKnown := make(map[int]bool)
+	for _, id := range known {
+		isKnown[id] = true
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(reqs, 1)
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		m := where.FindStringSubmatch(string(b))
+		if m == nil {
+			t.Errorf("got: <%s>, want query with ID filter", b)
+			return
+		}
+		ids := strings.Split(m[1], ",")
+
+		l := limit.FindStringSubmatch(string(b))
+		if l == nil || l[1] != strconv.Itoa(len(ids)) {
+			t.Errorf("got: <%s>, want limit of %d", b, len(ids))
+		}
+
+		// Respond in reverse order to check the results are reordered.
+		var g []*Game
+		for i := len(ids) - 1; i >= 0; i-- {
+			id, _ := strconv.Atoi(ids[i])
+			if id == failID {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if isKnown[id] {
+				g = append(g, &Game{ID: id})
+			}
+		}
+
+		if len(g) == 0 {
+			w.Write([]byte("[]"))
+			return
+		}
+
+		json.NewEncoder(w).Encode(g)
+	}
+}
+
+func TestGameService_List_Chunked(t *testing.T) {
+	tests := []struct {
+		name     string
+		ids      []int
+		known    []int
+		failID   int
+		wantIDs  []int
+		wantReqs int32
+		wantErr  error
+	}{
+		{"Single chunk", []int{3, 1}, []int{1, 2, 3}, -1, []int{3, 1}, 1, nil},
+		{"Multiple chunks", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}, -1, []int{5, 4, 3, 2, 1}, 3, nil},
+		{"Duplicate IDs", []int{1, 2, 1, 2, 3}, []int{1, 2, 3}, -1, []int{1, 2, 3}, 2, nil},
+		{"Unknown IDs", []int{1, 99, 2, 98, 3}, []int{1, 2, 3}, -1, []int{1, 2, 3}, 3, nil},
+		{"Chunk without results", []int{1, 2, 98, 99}, []int{1, 2}, -1, []int{1, 2}, 2, nil},
+		{"No results", []int{97, 98, 99}, nil, -1, nil, 2, ErrNoResults},
+		{"Failed chunk", []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}, 3, nil, -1, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int32
+			ts, c := testServerFunc(testChunkServer(t, test.known, test.failID, &reqs))
+			defer ts.Close()
+
+			c.SetListChunking(2, 2)
+
+			g, err := c.Games.List(test.ids)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var gotIDs []int
+			for _, game := range g {
+				gotIDs = append(gotIDs, game.ID)
+			}
+
+			if !reflect.DeepEqual(gotIDs, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", gotIDs, test.wantIDs)
+			}
+
+			if test.wantReqs >= 0 && atomic.LoadInt32(&reqs) != test.wantReqs {
+				t.Errorf("got: <%v> requests, want: <%v> requests", reqs, test.wantReqs)
+			}
+		})
+	}
+}
+
+func TestClient_SetListChunking(t *testing.T) {
+	tests := []struct {
+		name            string
+		size            int
+		concurrency     int
+		wantSize        int
+		wantConcurrency int
+	}{
+		{"Valid values", 100, 2, 100, 2},
+		{"Non-positive values", 0, -1, maxLimit, defaultListConcurrency},
+		{"Size above limit", maxLimit + 1, 1, maxLimit, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewClient(testClientID, testToken, nil)
+			c.SetListChunking(test.size, test.concurrency)
+
+			if c.chunkSize != test.wantSize {
+				t.Errorf("got: <%v>, want: <%v>", c.chunkSize, test.wantSize)
+			}
+
+			if c.chunkConcurrency != test.wantConcurrency {
+				t.Errorf("got: <%v>, want: <%v>", c.chunkConcurrency, test.wantConcurrency)
+			}
+		})
+	}
+}
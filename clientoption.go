@@ -0,0 +1,97 @@
+package igdb
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// Errors returned by a ClientOption when configuring a Client.
+var (
+	// ErrInvalidURL occurs when a root URL is not an absolute HTTP or HTTPS URL.
+	ErrInvalidURL = errors.New("URL must be an absolute HTTP or HTTPS URL")
+	// ErrEmptyClientOption occurs when a blank value is provided to a ClientOption.
+	ErrEmptyClientOption = errors.New("client option value cannot be blank")
+)
+
+// ClientOption functions are used to configure a Client created with New.
+// The options are applied in order after the Client's defaults are set.
+type ClientOption func(*Client) error
+
+// WithRootURL is a functional option used to set the root URL the Client
+// sends requests to, such as a staging host, a proxy, or a test server. The
+// URL must be an absolute HTTP or HTTPS URL. A trailing slash is added if
+// missing. The default root URL is https://api.igdb.com/v4/.
+func WithRootURL(root string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(root)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidURL, "cannot parse root URL '%s': %v", root, err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Wrapf(ErrInvalidURL, "invalid root URL '%s'", root)
+		}
+
+		if !strings.HasSuffix(root, "/") {
+			root += "/"
+		}
+
+		c.rootURL = root
+		return nil
+	}
+}
+
+// WithUserAgent is a functional option used to set the user agent sent with
+// each request. The default user agent is HenrySarabia/igdb.
+func WithUserAgent(agent string) ClientOption {
+	return func(c *Client) error {
+		if blank.Is(agent) {
+			return errors.Wrap(ErrEmptyClientOption, "user agent is blank")
+		}
+
+		c.userAgent = agent
+		return nil
+	}
+}
+
+// WithHTTPClient is a functional option used to set the HTTP client making
+// requests to the IGDB. If the provided HTTP client is nil, the default HTTP
+// client is used instead.
+func WithHTTPClient(custom *http.Client) ClientOption {
+	return func(c *Client) error {
+		if custom == nil {
+			custom = http.DefaultClient
+		}
+
+		c.http = custom
+		return nil
+	}
+}
+
+// WithHeader is a functional option used to add a header to each request.
+// The headers required to communicate with the IGDB, such as the
+// authorization and client ID headers, cannot be overridden.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if blank.Is(key) {
+			return errors.Wrap(ErrEmptyClientOption, "header key is blank")
+		}
+
+		c.header.Add(key, value)
+		return nil
+	}
+}
+
+// WithoutCompression is a functional option used to stop the Client from
+// requesting gzip-compressed responses from the IGDB. This is useful when
+// inspecting the raw responses, such as when debugging with a proxy.
+func WithoutCompression() ClientOption {
+	return func(c *Client) error {
+		c.noCompression = true
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+package igdb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestNew(t *testing.T) {
+	custom := &http.Client{}
+
+	tests := []struct {
+		name          string
+		opts          []ClientOption
+		wantRootURL   string
+		wantUserAgent string
+		wantHTTP      *http.Client
+		wantErr       error
+	}{
+		{"No options", nil, igdbURL, defaultUserAgent, http.DefaultClient, nil},
+		{"Root URL with trailing slash", []ClientOption{WithRootURL("https://example.com/v4/")}, "https://example.com/v4/", defaultUserAgent, http.DefaultClient, nil},
+		{"Root URL without trailing slash", []ClientOption{WithRootURL("http://127.0.0.1:8080")}, "http://127.0.0.1:8080/", defaultUserAgent, http.DefaultClient, nil},
+		{"Relative root URL", []ClientOption{WithRootURL("api.igdb.com/v4")}, "", "", nil, ErrInvalidURL},
+		{"Unsupported root URL scheme", []ClientOption{WithRootURL("ftp://api.igdb.com/v4/")}, "", "", nil, ErrInvalidURL},
+		{"Malformed root URL", []ClientOption{WithRootURL("https://api igdb com/%zz")}, "", "", nil, ErrInvalidURL},
+		{"User agent", []ClientOption{WithUserAgent("my-app/1.0")}, igdbURL, "my-app/1.0", http.DefaultClient, nil},
+		{"Blank user agent", []ClientOption{WithUserAgent(" ")}, "", "", nil, ErrEmptyClientOption},
+		{"HTTP client", []ClientOption{WithHTTPClient(custom)}, igdbURL, defaultUserAgent, custom, nil},
+		{"Nil HTTP client", []ClientOption{WithHTTPClient(nil)}, igdbURL, defaultUserAgent, http.DefaultClient, nil},
+		{"Blank header key", []ClientOption{WithHeader("", "value")}, "", "", nil, ErrEmptyClientOption},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := New(testClientID, testToken, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				if c != nil {
+					t.Errorf("got: <%v>, want: <nil>", c)
+				}
+				return
+			}
+
+			if c.rootURL != test.wantRootURL {
+				t.Errorf("got: <%v>, want: <%v>", c.rootURL, test.wantRootURL)
+			}
+
+			if c.userAgent != test.wantUserAgent {
+				t.Errorf("got: <%v>, want: <%v>", c.userAgent, test.wantUserAgent)
+			}
+
+			if c.http != test.wantHTTP {
+				t.Errorf("got: <%v>, want: <%v>", c.http, test.wantHTTP)
+			}
+
+			if c.Games == nil || c.Games.client != c {
+				t.Errorf("got: <%v>, want initialized services", c.Games)
+			}
+		})
+	}
+}
+
+func TestNew_Request(t *testing.T) {
+	var got http.Header
+	ts, _ := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.Write([]byte(testResult))
+	})
+	defer ts.Close()
+
+	c, err := New(testClientID, testToken,
+		WithRootURL(ts.URL),
+		WithHTTPClient(ts.Client()),
+		WithUserAgent("my-app/1.0"),
+		WithHeader("X-Request-Source", "test"),
+		WithHeader("Client-ID", "overridden"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := testResultPlaceholder{}
+	if err := c.post(testEndpoint, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"User-Agent":       "my-app/1.0",
+		"X-User-Agent":     "my-app/1.0",
+		"X-Request-Source": "test",
+		"Client-Id":        testClientID,
+		"Authorization":    "Bearer " + testToken,
+	}
+	for k, v := range want {
+		if got.Get(k) != v {
+			t.Errorf("got %s: <%v>, want: <%v>", k, got.Get(k), v)
+		}
+	}
+
+	if len(got["Client-Id"]) != 1 {
+		t.Errorf("got: <%v>, want a single client ID header", got["Client-Id"])
+	}
+}
@@ -0,0 +1,129 @@
+package igdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// SetRequestCoalescing configures whether the Client coalesces identical
+// concurrent API calls. When enabled, concurrent calls to the same endpoint
+// with the same options share a single request to the IGDB, and therefore a
+// single request against the rate limit. Each caller decodes its own copy of
+// the results, so callers never share the values they receive. An error from
+// the shared request, including the cancellation of the context of the call
+// that sent it, is returned to every caller sharing it. Coalescing is disabled
+// by default. SetRequestCoalescing should be called before the Client is used.
+func (c *Client) SetRequestCoalescing(enabled bool) {
+	c.coalesce = enabled
+}
+
+// flight is an in-progress or completed request shared by coalesced calls.
+type flight struct {
+	wg   sync.WaitGroup
+	dups int
+	resp *Response
+	body []byte
+	err  error
+}
+
+// flightGroup tracks the requests currently in progress, keyed by the
+// endpoint and query of each request.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// do calls the provided function and returns its results, unless a call with
+// the provided key is already in progress, in which case do waits for that
+// call to complete and returns its results instead.
+func (g *flightGroup) do(key string, fn func() (*Response, []byte, error)) (*Response, []byte, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*flight)
+	}
+
+	if f, ok := g.flights[key]; ok {
+		f.dups++
+		g.mu.Unlock()
+		f.wg.Wait()
+		return f.resp, f.body, f.err
+	}
+
+	f := &flight{}
+	f.wg.Add(1)
+	g.flights[key] = f
+	g.mu.Unlock()
+
+	f.resp, f.body, f.err = fn()
+	f.wg.Done()
+
+	g.mu.Lock()
+	delete(g.flights, key)
+	g.mu.Unlock()
+
+	return f.resp, f.body, f.err
+}
+
+// sendCoalesced sends the provided request and stores the response in the
+// value pointed to by result, sharing the request with any identical request
+// already in progress.
+func (c *Client) sendCoalesced(req *http.Request, result interface{}) (*Response, error) {
+	r, b, err := c.fetch(req)
+	if err != nil {
+		return r, err
+	}
+
+	return r, decodeResults(bytes.NewReader(b), result)
+}
+
+// fetch sends the provided request and returns the metadata and body of the
+// response. If the Client coalesces requests, the request is shared with any
+// identical request already in progress and each caller receives its own copy
+// of the metadata.
+func (c *Client) fetch(req *http.Request) (*Response, []byte, error) {
+	r, b, err := c.fetchShared(req)
+	traceFrom(req.Context()).size = int64(len(b))
+
+	return r, b, err
+}
+
+// fetchShared sends the provided request, or shares an identical request
+// already in progress if the Client coalesces requests, and returns the
+// metadata and body of the response.
+func (c *Client) fetchShared(req *http.Request) (*Response, []byte, error) {
+	if !c.coalesce {
+		return c.receiveBody(req)
+	}
+
+	key, err := cacheKey(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, b, err := c.flights.do(key, func() (*Response, []byte, error) {
+		return c.receiveBody(req)
+	})
+
+	return r.copy(), b, err
+}
+
+// receiveBody sends the provided request, checks the response for errors, and
+// returns the metadata and the entire body of the response.
+func (c *Client) receiveBody(req *http.Request) (*Response, []byte, error) {
+	r, resp, err := c.receive(req)
+	if err != nil {
+		return r, nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return r, nil, errors.Wrap(err, "cannot read response body")
+	}
+
+	return r, b, nil
+}
@@ -0,0 +1,135 @@
+package igdb
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// waitFor calls the provided function until it returns true, failing the
+// test if it does not do so within a second.
+func waitFor(t *testing.T, fn func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !fn() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// dupCount returns the number of calls waiting on another call in the
+// provided flightGroup.
+func (g *flightGroup) dupCount() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var n int
+	for _, f := range g.flights {
+		n += f.dups
+	}
+
+	return n
+}
+
+func TestClient_RequestCoalescing(t *testing.T) {
+	tests := []struct {
+		name     string
+		coalesce bool
+		ids      []int
+		status   int
+		wantReqs int32
+		wantErr  error
+	}{
+		{"Identical calls coalesced", true, []int{1, 1, 1, 1}, http.StatusOK, 1, nil},
+		{"Different calls not coalesced", true, []int{1, 2, 1, 2}, http.StatusOK, 2, nil},
+		{"Coalescing disabled", false, []int{1, 1, 1, 1}, http.StatusOK, 4, nil},
+		{"Shared error", true, []int{1, 1, 1}, http.StatusBadRequest, 1, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int32
+			release := make(chan struct{})
+
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&reqs, 1)
+				<-release
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(`[{"id": 1, "name": "Shared"}]`))
+			})
+			defer ts.Close()
+
+			c.SetRequestCoalescing(test.coalesce)
+
+			games := make([]*Game, len(test.ids))
+			errs := make([]error, len(test.ids))
+
+			var wg sync.WaitGroup
+			for i, id := range test.ids {
+				wg.Add(1)
+				go func(i, id int) {
+					defer wg.Done()
+					games[i], errs[i] = c.Games.Get(id)
+				}(i, id)
+			}
+
+			wantDups := len(test.ids) - int(test.wantReqs)
+			waitFor(t, func() bool {
+				return atomic.LoadInt32(&reqs) == test.wantReqs && c.flights.dupCount() == wantDups
+			})
+			close(release)
+			wg.Wait()
+
+			if got := atomic.LoadInt32(&reqs); got != test.wantReqs {
+				t.Errorf("got: <%v> requests, want: <%v> requests", got, test.wantReqs)
+			}
+
+			for _, err := range errs {
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+				}
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			// Each caller must receive its own values.
+			games[0].Name = "Modified"
+			for _, g := range games[1:] {
+				if g.Name != "Shared" {
+					t.Errorf("got: <%v>, want: <%v>", g.Name, "Shared")
+				}
+			}
+		})
+	}
+}
+
+func TestFlightGroup_Sequential(t *testing.T) {
+	var g flightGroup
+	var calls int
+
+	for i := 0; i < 3; i++ {
+		_, b, err := g.do("key", func() (*Response, []byte, error) {
+			calls++
+			return &Response{Status: http.StatusOK}, []byte("[]"), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != "[]" {
+			t.Errorf("got: <%s>, want: <%s>", b, "[]")
+		}
+	}
+
+	// Completed calls must not be shared with later calls.
+	if calls != 3 {
+		t.Errorf("got: <%v> calls, want: <%v> calls", calls, 3)
+	}
+}
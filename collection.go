@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,12 +12,13 @@ import (
 // Collection represents a video game series.
 // For more information visit: https://api-docs.igdb.com/#collection
 type Collection struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Games     []int     `json:"games"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // CollectionService handles all the API calls for the IGDB Collection endpoint.
@@ -26,14 +28,23 @@ type CollectionService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Collections, an error is returned.
 func (cs *CollectionService) Get(id int, opts ...Option) (*Collection, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Collection identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any Collections,
+// an error is returned.
+func (cs *CollectionService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Collection, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var col []*Collection
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &col, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &col, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Collection with ID %v", id)
 	}
@@ -46,6 +57,15 @@ func (cs *CollectionService) Get(id int, opts ...Option) (*Collection, error) {
 // Any ID that does not match a Collection is ignored. If none of the IDs
 // match a Collection, an error is returned.
 func (cs *CollectionService) List(ids []int, opts ...Option) ([]*Collection, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Collections identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Collection is ignored. If
+// none of the IDs match a Collection, an error is returned.
+func (cs *CollectionService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Collection, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -59,7 +79,7 @@ func (cs *CollectionService) List(ids []int, opts ...Option) ([]*Collection, err
 	var col []*Collection
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &col, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &col, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Collections with IDs %v", ids)
 	}
@@ -71,9 +91,18 @@ func (cs *CollectionService) List(ids []int, opts ...Option) ([]*Collection, err
 // options used to sort, filter, and paginate the results. If no Collections can
 // be found using the provided options, an error is returned.
 func (cs *CollectionService) Index(opts ...Option) ([]*Collection, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Collections based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Collections can be found using the provided options, an error
+// is returned.
+func (cs *CollectionService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Collection, error) {
 	var col []*Collection
 
-	err := cs.client.post(cs.end, &col, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &col, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Collections")
 	}
@@ -85,10 +114,19 @@ func (cs *CollectionService) Index(opts ...Option) ([]*Collection, error) {
 // query. Provide functional options to sort, filter, and paginate the results. If
 // no Collections are found using the provided query, an error is returned.
 func (cs *CollectionService) Search(qry string, opts ...Option) ([]*Collection, error) {
+	return cs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Collections found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Collections are found using the
+// provided query, an error is returned.
+func (cs *CollectionService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Collection, error) {
 	var col []*Collection
 
 	opts = append(opts, setSearch(qry))
-	err := cs.client.post(cs.end, &col, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &col, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Collection with query %s", qry)
 	}
@@ -96,11 +134,61 @@ func (cs *CollectionService) Search(qry string, opts ...Option) ([]*Collection,
 	return col, nil
 }
 
+// GamesIn returns the IGDB IDs of the Games belonging to the Collection
+// identified by the provided IGDB ID. If the ID does not match any
+// Collections, an error is returned. If the Collection exists but has no
+// Games, an empty slice is returned without an error.
+func (cs *CollectionService) GamesIn(collectionID int) ([]int, error) {
+	col, err := cs.Get(collectionID, SetFields("games"))
+	if err != nil {
+		return nil, err
+	}
+
+	if col.Games == nil {
+		return []int{}, nil
+	}
+
+	return col.Games, nil
+}
+
+// ListGamesIn returns the Games belonging to the Collection identified by the
+// provided IGDB ID. The Collection and its Games are retrieved using separate
+// requests. Provide functional options to sort, filter, and paginate the Games.
+// If the ID does not match any Collections, an error is returned. If the
+// Collection exists but has no Games, an empty slice is returned without an
+// error.
+func (cs *CollectionService) ListGamesIn(collectionID int, opts ...Option) ([]*Game, error) {
+	ids, err := cs.GamesIn(collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return []*Game{}, nil
+	}
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	g, err := cs.client.Games.List(ids, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games in Collection with ID %v", collectionID)
+	}
+
+	return g, nil
+}
+
 // Count returns the number of Collections available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Collections to count.
 func (cs *CollectionService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Collections available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Collections to count.
+func (cs *CollectionService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Collections")
 	}
@@ -111,7 +199,14 @@ func (cs *CollectionService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Collection object.
 func (cs *CollectionService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Collection
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (cs *CollectionService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Collection fields")
 	}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -50,7 +51,7 @@ func TestCollectionService_Get(t *testing.T) {
 			defer ts.Close()
 
 			col, err := c.Collections.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -97,7 +98,7 @@ func TestCollectionService_List(t *testing.T) {
 			defer ts.Close()
 
 			col, err := c.Collections.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -141,7 +142,7 @@ func TestCollectionService_Index(t *testing.T) {
 			defer ts.Close()
 
 			col, err := c.Collections.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -187,7 +188,7 @@ func TestCollectionService_Search(t *testing.T) {
 			defer ts.Close()
 
 			col, err := c.Collections.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -198,6 +199,98 @@ func TestCollectionService_Search(t *testing.T) {
 	}
 }
 
+func TestCollectionService_GamesIn(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		id        int
+		wantGames []int
+		wantErr   error
+	}{
+		{"Valid response", `[{"id": 286, "games": [1289, 1770, 1773]}]`, 286, []int{1289, 1770, 1773}, nil},
+		{"Empty games", `[{"id": 286}]`, 286, []int{}, nil},
+		{"Invalid ID", "", -1, nil, ErrNegativeID},
+		{"Empty response", "", 286, nil, errInvalidJSON},
+		{"No results", "[]", 0, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			g, err := c.Collections.GamesIn(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+		})
+	}
+}
+
+func TestCollectionService_ListGamesIn(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		colResp   string
+		gameFile  string
+		id        int
+		wantReqs  int
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", `[{"id": 286, "games": [105842, 32478, 98774, 104945, 69530]}]`, testGameList, 286, 2, init, nil},
+		{"Empty games", `[{"id": 286, "games": []}]`, testGameList, 286, 1, []*Game{}, nil},
+		{"Missing collection", "[]", testGameList, 286, 1, nil, ErrNoResults},
+		{"No games found", `[{"id": 286, "games": [1289]}]`, testFileEmptyArray, 286, 2, nil, ErrNoResults},
+		{"Invalid ID", "", testGameList, -1, 0, nil, ErrNegativeID},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reqs++
+				if strings.Contains(r.URL.Path, string(EndpointCollection)) {
+					w.Write([]byte(test.colResp))
+					return
+				}
+
+				resp, err := ioutil.ReadFile(test.gameFile)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Collections.ListGamesIn(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if reqs != test.wantReqs {
+				t.Errorf("got: <%v>, want: <%v>", reqs, test.wantReqs)
+			}
+		})
+	}
+}
+
 func TestCollectionService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -218,7 +311,7 @@ func TestCollectionService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Collections.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -248,7 +341,7 @@ func TestCollectionService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Collections.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
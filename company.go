@@ -1,8 +1,10 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
+	"reflect"
 	"strconv"
 )
 
@@ -13,11 +15,11 @@ import (
 // For more information visit: https://api-docs.igdb.com/#company
 type Company struct {
 	ID                 int          `json:"id"`
-	ChangeDate         int          `json:"change_date"`
+	ChangeDate         Timestamp    `json:"change_date"`
 	ChangeDateCategory DateCategory `json:"change_date_category"`
 	ChangedCompanyID   int          `json:"changed_company_id"`
 	Country            int          `json:"country"`
-	CreatedAt          int          `json:"created_at"`
+	CreatedAt          Timestamp    `json:"created_at"`
 	Description        string       `json:"description"`
 	Developed          []int        `json:"developed"`
 	Logo               int          `json:"logo"`
@@ -25,13 +27,56 @@ type Company struct {
 	Parent             int          `json:"parent"`
 	Published          []int        `json:"published"`
 	Slug               string       `json:"slug"`
-	StartDate          int          `json:"start_date"`
+	StartDate          Timestamp    `json:"start_date"`
 	StartDateCategory  DateCategory `json:"start_date_category"`
-	UpdatedAt          int          `json:"updated_at"`
+	UpdatedAt          Timestamp    `json:"updated_at"`
 	URL                string       `json:"url"`
 	Websites           []int        `json:"websites"`
 }
 
+// maxAcquisitionHops is the maximum number of changed companies followed
+// when retrieving the acquisition history of a Company.
+const maxAcquisitionHops = 10
+
+// CompanyChange represents a single entry in the acquisition history of a
+// Company. ChangeDate is the date on which the Company was acquired or
+// renamed, ChangeDateCategory describes the precision of that date, and
+// ChangeKind describes how the Company changed into the next Company in the
+// history. For the most recent Company in a history, ChangeDate is the zero
+// Timestamp and ChangeKind is ChangeNone.
+type CompanyChange struct {
+	Company            *Company
+	ChangeDate         Timestamp
+	ChangeDateCategory DateCategory
+	ChangeKind         CompanyChangeKind
+}
+
+//go:generate stringer -type=CompanyChangeKind -linecomment
+
+// CompanyChangeKind specifies how a Company changed into another Company.
+// A Company that changed into its parent Company was acquired by it, while
+// any other change is a rename.
+type CompanyChangeKind int
+
+// Expected CompanyChangeKind enums.
+const (
+	ChangeNone        CompanyChangeKind = iota // none
+	ChangeRename                               // rename
+	ChangeAcquisition                          // acquisition
+)
+
+// changeKind returns the kind of change the provided Company went through.
+func changeKind(comp *Company) CompanyChangeKind {
+	switch {
+	case comp.ChangedCompanyID == 0:
+		return ChangeNone
+	case comp.ChangedCompanyID == comp.Parent:
+		return ChangeAcquisition
+	default:
+		return ChangeRename
+	}
+}
+
 // CompanyService handles all the API calls for the IGDB Company endpoint.
 type CompanyService service
 
@@ -39,14 +84,23 @@ type CompanyService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Companies, an error is returned.
 func (cs *CompanyService) Get(id int, opts ...Option) (*Company, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Company identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Companies, an error is
+// returned.
+func (cs *CompanyService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Company, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var comp []*Company
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &comp, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &comp, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Company with ID %v", id)
 	}
@@ -59,6 +113,15 @@ func (cs *CompanyService) Get(id int, opts ...Option) (*Company, error) {
 // Any ID that does not match a Company is ignored. If none of the IDs
 // match a Company, an error is returned.
 func (cs *CompanyService) List(ids []int, opts ...Option) ([]*Company, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Companies identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Company is ignored. If
+// none of the IDs match a Company, an error is returned.
+func (cs *CompanyService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Company, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -72,7 +135,7 @@ func (cs *CompanyService) List(ids []int, opts ...Option) ([]*Company, error) {
 	var comp []*Company
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &comp, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &comp, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Companies with IDs %v", ids)
 	}
@@ -84,9 +147,18 @@ func (cs *CompanyService) List(ids []int, opts ...Option) ([]*Company, error) {
 // options used to sort, filter, and paginate the results. If no Companies can
 // be found using the provided options, an error is returned.
 func (cs *CompanyService) Index(opts ...Option) ([]*Company, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Companies based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Companies can be found using the provided options, an error
+// is returned.
+func (cs *CompanyService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Company, error) {
 	var comp []*Company
 
-	err := cs.client.post(cs.end, &comp, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &comp, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Companies")
 	}
@@ -94,11 +166,81 @@ func (cs *CompanyService) Index(opts ...Option) ([]*Company, error) {
 	return comp, nil
 }
 
+// Search returns a list of Companies found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Companies are found using the provided query, an error is returned.
+func (cs *CompanyService) Search(qry string, opts ...Option) ([]*Company, error) {
+	return cs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Companies found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Companies are found using the
+// provided query, an error is returned.
+func (cs *CompanyService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Company, error) {
+	var comp []*Company
+
+	opts = append(opts, setSearch(qry))
+	err := cs.client.postWithContext(ctx, cs.end, &comp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Company with query %s", qry)
+	}
+
+	return comp, nil
+}
+
+// GetAcquisitionHistory returns the acquisition history of the Company
+// identified by the provided IGDB ID. The history begins with the provided
+// Company and follows each Company it was changed into, whether through an
+// acquisition or a rename, until a Company without any changes is reached.
+// At most 10 changes are followed and any Company already in the history
+// ends the history to prevent cycles. If the ID does not match any
+// Companies, an error is returned.
+func (cs *CompanyService) GetAcquisitionHistory(companyID int) ([]*CompanyChange, error) {
+	if companyID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var hist []*CompanyChange
+	seen := make(map[int]bool)
+
+	for id, hops := companyID, 0; hops <= maxAcquisitionHops && !seen[id]; hops++ {
+		comp, err := cs.Get(id, SetFields("*"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get acquisition history of Company with ID %v", companyID)
+		}
+
+		seen[id] = true
+		hist = append(hist, &CompanyChange{
+			Company:            comp,
+			ChangeDate:         comp.ChangeDate,
+			ChangeDateCategory: comp.ChangeDateCategory,
+			ChangeKind:         changeKind(comp),
+		})
+
+		if comp.ChangedCompanyID == 0 {
+			break
+		}
+		id = comp.ChangedCompanyID
+	}
+
+	return hist, nil
+}
+
 // Count returns the number of Companies available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Companies to count.
 func (cs *CompanyService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Companies available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Companies to count.
+func (cs *CompanyService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Companies")
 	}
@@ -109,10 +251,24 @@ func (cs *CompanyService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Company object.
 func (cs *CompanyService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Company
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (cs *CompanyService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Company fields")
 	}
 
 	return f, nil
 }
+
+// StructFields returns the JSON field names of the Company struct. Unlike
+// Fields, StructFields does not make an API call. This is useful for building
+// SetFields functional options without mistyping a field name.
+func (cs *CompanyService) StructFields() []string {
+	return cachedStructFields(reflect.TypeOf(Company{}))
+}
@@ -2,11 +2,14 @@ package igdb
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -49,7 +52,7 @@ func TestCompanyService_Get(t *testing.T) {
 			defer ts.Close()
 
 			comp, err := c.Companies.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +99,7 @@ func TestCompanyService_List(t *testing.T) {
 			defer ts.Close()
 
 			comp, err := c.Companies.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +143,7 @@ func TestCompanyService_Index(t *testing.T) {
 			defer ts.Close()
 
 			comp, err := c.Companies.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -151,6 +154,121 @@ func TestCompanyService_Index(t *testing.T) {
 	}
 }
 
+func TestCompanyService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testCompanyList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Company, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name          string
+		file          string
+		qry           string
+		opts          []Option
+		wantCompanies []*Company
+		wantErr       error
+	}{
+		{"Valid response", testCompanyList, "nintendo", []Option{SetLimit(5)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(5)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "nintendo", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "nintendo", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			comp, err := c.Companies.Search(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(comp, test.wantCompanies) {
+				t.Errorf("got: <%v>, \nwant: <%v>", comp, test.wantCompanies)
+			}
+		})
+	}
+}
+
+func TestCompanyService_GetAcquisitionHistory(t *testing.T) {
+	chain := func(ids ...int) []int { return ids }
+	kinds := func(k ...CompanyChangeKind) []CompanyChangeKind { return k }
+
+	var tests = []struct {
+		name      string
+		changes   map[int]int
+		parents   map[int]int
+		id        int
+		wantChain []int
+		wantKinds []CompanyChangeKind
+		wantErr   error
+	}{
+		{"No changes", map[int]int{1: 0}, nil, 1, chain(1), kinds(ChangeNone), nil},
+		{"Multiple changes", map[int]int{1: 2, 2: 3, 3: 0}, nil, 1, chain(1, 2, 3), kinds(ChangeRename, ChangeRename, ChangeNone), nil},
+		{"Acquisition", map[int]int{1: 2, 2: 3, 3: 0}, map[int]int{1: 2, 2: 7}, 1, chain(1, 2, 3), kinds(ChangeAcquisition, ChangeRename, ChangeNone), nil},
+		{"Cycle", map[int]int{1: 2, 2: 1}, nil, 1, chain(1, 2), kinds(ChangeRename, ChangeRename), nil},
+		{"Too many changes", map[int]int{1: 2, 2: 3, 3: 4, 4: 5, 5: 6, 6: 7, 7: 8, 8: 9, 9: 10, 10: 11, 11: 12, 12: 13}, nil, 1, chain(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11), nil, nil},
+		{"Invalid ID", map[int]int{}, nil, -1, nil, nil, ErrNegativeID},
+		{"Missing company", map[int]int{1: 2}, nil, 1, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var id int
+				fmt.Sscanf(string(b[strings.Index(string(b), "id = "):]), "id = %d", &id)
+
+				next, ok := test.changes[id]
+				if !ok {
+					w.Write([]byte("[]"))
+					return
+				}
+
+				comp := []*Company{{ID: id, ChangedCompanyID: next, Parent: test.parents[id], ChangeDate: Timestamp{time.Unix(int64(id*1000), 0)}, ChangeDateCategory: DateYYYY}}
+				json.NewEncoder(w).Encode(comp)
+			})
+			defer ts.Close()
+
+			hist, err := c.Companies.GetAcquisitionHistory(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var got []int
+			var gotKinds []CompanyChangeKind
+			for _, ch := range hist {
+				got = append(got, ch.Company.ID)
+				gotKinds = append(gotKinds, ch.ChangeKind)
+				if ch.ChangeDate.Unix() != int64(ch.Company.ID*1000) || ch.ChangeDateCategory != DateYYYY {
+					t.Errorf("got: <%v>, want change date and category of Company %v", ch, ch.Company.ID)
+				}
+			}
+
+			if !reflect.DeepEqual(got, test.wantChain) {
+				t.Errorf("got: <%v>, \nwant: <%v>", got, test.wantChain)
+			}
+
+			if test.wantKinds != nil && !reflect.DeepEqual(gotKinds, test.wantKinds) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gotKinds, test.wantKinds)
+			}
+		})
+	}
+}
+
 func TestCompanyService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -171,7 +289,7 @@ func TestCompanyService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Companies.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +319,7 @@ func TestCompanyService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Companies.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -211,3 +329,17 @@ func TestCompanyService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestCompanyService_StructFields(t *testing.T) {
+	c := NewClient(testClientID, testToken, nil)
+
+	fields := c.Companies.StructFields()
+	want := []string{"id", "change_date", "change_date_category", "changed_company_id", "country", "created_at", "description", "developed", "logo", "name", "parent", "published", "slug", "start_date", "start_date_category", "updated_at", "url", "websites"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("got: <%v>, \nwant: <%v>", fields, want)
+	}
+
+	if _, err := SetFields(fields...)(); err != nil {
+		t.Errorf("got: <%v>, want usable SetFields option", err)
+	}
+}
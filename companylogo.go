@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -20,14 +21,23 @@ type CompanyLogoService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any CompanyLogos, an error is returned.
 func (cs *CompanyLogoService) Get(id int, opts ...Option) (*CompanyLogo, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single CompanyLogo identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any CompanyLogos,
+// an error is returned.
+func (cs *CompanyLogoService) GetWithContext(ctx context.Context, id int, opts ...Option) (*CompanyLogo, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var logo []*CompanyLogo
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &logo, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CompanyLogo with ID %v", id)
 	}
@@ -40,6 +50,15 @@ func (cs *CompanyLogoService) Get(id int, opts ...Option) (*CompanyLogo, error)
 // Any ID that does not match a CompanyLogo is ignored. If none of the IDs
 // match a CompanyLogo, an error is returned.
 func (cs *CompanyLogoService) List(ids []int, opts ...Option) ([]*CompanyLogo, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of CompanyLogos identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a CompanyLogo is ignored. If
+// none of the IDs match a CompanyLogo, an error is returned.
+func (cs *CompanyLogoService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*CompanyLogo, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -53,7 +72,7 @@ func (cs *CompanyLogoService) List(ids []int, opts ...Option) ([]*CompanyLogo, e
 	var logo []*CompanyLogo
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &logo, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CompanyLogos with IDs %v", ids)
 	}
@@ -65,9 +84,18 @@ func (cs *CompanyLogoService) List(ids []int, opts ...Option) ([]*CompanyLogo, e
 // options used to sort, filter, and paginate the results. If no CompanyLogos can
 // be found using the provided options, an error is returned.
 func (cs *CompanyLogoService) Index(opts ...Option) ([]*CompanyLogo, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of CompanyLogos based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no CompanyLogos can be found using the provided options, an
+// error is returned.
+func (cs *CompanyLogoService) IndexWithContext(ctx context.Context, opts ...Option) ([]*CompanyLogo, error) {
 	var logo []*CompanyLogo
 
-	err := cs.client.post(cs.end, &logo, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of CompanyLogos")
 	}
@@ -79,7 +107,15 @@ func (cs *CompanyLogoService) Index(opts ...Option) ([]*CompanyLogo, error) {
 // Provide the SetFilter functional option if you need to filter
 // which CompanyLogos to count.
 func (cs *CompanyLogoService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of CompanyLogos available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which CompanyLogos to count.
+func (cs *CompanyLogoService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count CompanyLogos")
 	}
@@ -90,7 +126,14 @@ func (cs *CompanyLogoService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB CompanyLogo object.
 func (cs *CompanyLogoService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// CompanyLogo object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (cs *CompanyLogoService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get CompanyLogo fields")
 	}
@@ -49,7 +49,7 @@ func TestCompanyLogoService_Get(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.CompanyLogos.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestCompanyLogoService_List(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.CompanyLogos.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestCompanyLogoService_Index(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.CompanyLogos.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestCompanyLogoService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.CompanyLogos.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +201,7 @@ func TestCompanyLogoService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.CompanyLogos.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
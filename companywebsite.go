@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -24,14 +25,23 @@ type CompanyWebsiteService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any CompanyWebsites, an error is returned.
 func (zs *CompanyWebsiteService) Get(id int, opts ...Option) (*CompanyWebsite, error) {
+	return zs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single CompanyWebsite identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// CompanyWebsites, an error is returned.
+func (zs *CompanyWebsiteService) GetWithContext(ctx context.Context, id int, opts ...Option) (*CompanyWebsite, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var web []*CompanyWebsite
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := zs.client.post(zs.end, &web, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := zs.client.postWithContext(ctx, zs.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CompanyWebsite with ID %v", id)
 	}
@@ -44,6 +54,15 @@ func (zs *CompanyWebsiteService) Get(id int, opts ...Option) (*CompanyWebsite, e
 // Any ID that does not match a CompanyWebsite is ignored. If none of the IDs
 // match a CompanyWebsite, an error is returned.
 func (zs *CompanyWebsiteService) List(ids []int, opts ...Option) ([]*CompanyWebsite, error) {
+	return zs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of CompanyWebsites identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a CompanyWebsite is ignored.
+// If none of the IDs match a CompanyWebsite, an error is returned.
+func (zs *CompanyWebsiteService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*CompanyWebsite, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -57,7 +76,7 @@ func (zs *CompanyWebsiteService) List(ids []int, opts ...Option) ([]*CompanyWebs
 	var web []*CompanyWebsite
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := zs.client.post(zs.end, &web, opts...)
+	err := zs.client.postWithContext(ctx, zs.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get CompanyWebsites with IDs %v", ids)
 	}
@@ -69,9 +88,18 @@ func (zs *CompanyWebsiteService) List(ids []int, opts ...Option) ([]*CompanyWebs
 // options used to sort, filter, and paginate the results. If no CompanyWebsites can
 // be found using the provided options, an error is returned.
 func (zs *CompanyWebsiteService) Index(opts ...Option) ([]*CompanyWebsite, error) {
+	return zs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of CompanyWebsites based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no CompanyWebsites can be found using the provided options,
+// an error is returned.
+func (zs *CompanyWebsiteService) IndexWithContext(ctx context.Context, opts ...Option) ([]*CompanyWebsite, error) {
 	var web []*CompanyWebsite
 
-	err := zs.client.post(zs.end, &web, opts...)
+	err := zs.client.postWithContext(ctx, zs.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of CompanyWebsites")
 	}
@@ -83,7 +111,15 @@ func (zs *CompanyWebsiteService) Index(opts ...Option) ([]*CompanyWebsite, error
 // Provide the SetFilter functional option if you need to filter
 // which CompanyWebsites to count.
 func (zs *CompanyWebsiteService) Count(opts ...Option) (int, error) {
-	ct, err := zs.client.getCount(zs.end, opts...)
+	return zs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of CompanyWebsites available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which CompanyWebsites to count.
+func (zs *CompanyWebsiteService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := zs.client.getCountWithContext(ctx, zs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count CompanyWebsites")
 	}
@@ -94,7 +130,14 @@ func (zs *CompanyWebsiteService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB CompanyWebsite object.
 func (zs *CompanyWebsiteService) Fields() ([]string, error) {
-	f, err := zs.client.getFields(zs.end)
+	return zs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// CompanyWebsite object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (zs *CompanyWebsiteService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := zs.client.getFieldsWithContext(ctx, zs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get CompanyWebsite fields")
 	}
@@ -49,7 +49,7 @@ func TestCompanyWebsiteService_Get(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.CompanyWebsites.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestCompanyWebsiteService_List(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.CompanyWebsites.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestCompanyWebsiteService_Index(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.CompanyWebsites.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestCompanyWebsiteService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.CompanyWebsites.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestCompanyWebsiteService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.CompanyWebsites.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
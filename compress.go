@@ -0,0 +1,49 @@
+package igdb
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// gzipBody decompresses a gzip-compressed response body and closes both the
+// decompressor and the underlying body when closed.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close closes the decompressor and the underlying response body.
+func (g *gzipBody) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
+// decompress replaces the body of the provided response with a decompressing
+// reader if the response is gzip-compressed. Responses already decompressed
+// by the HTTP client's transport are left untouched.
+func decompress(resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		if err == io.EOF {
+			// An empty body has nothing to decompress.
+			return nil
+		}
+		return errors.Wrap(err, "cannot decompress response body")
+	}
+
+	resp.Body = &gzipBody{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package igdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// gzipped returns the provided string compressed with gzip.
+func gzipped(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestClient_Compression(t *testing.T) {
+	tests := []struct {
+		name          string
+		compress      bool
+		opts          []ClientOption
+		status        int
+		resp          string
+		wantEncoding  string
+		wantRes       testResultPlaceholder
+		wantErr       error
+		wantErrDetail string
+	}{
+		{"Compressing server", true, nil, http.StatusOK, testResult, "gzip", testResultPlaceholder{SomeField: "some_value"}, nil, ""},
+		{"Non-compressing server", false, nil, http.StatusOK, testResult, "gzip", testResultPlaceholder{SomeField: "some_value"}, nil, ""},
+		{"Compressed empty results", true, nil, http.StatusOK, "[]", "gzip", testResultPlaceholder{}, ErrNoResults, ""},
+		{"Compressed error response", true, nil, http.StatusBadRequest, `[{"title": "Syntax Error", "status": 400}]`, "gzip", testResultPlaceholder{}, ErrBadRequest, "Syntax Error"},
+		{"Without compression", true, []ClientOption{WithoutCompression()}, http.StatusOK, testResult, "identity", testResultPlaceholder{SomeField: "some_value"}, nil, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var gotEncoding string
+			ts, _ := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotEncoding = r.Header.Get("Accept-Encoding")
+
+				if test.compress && strings.Contains(gotEncoding, "gzip") {
+					w.Header().Set("Content-Encoding", "gzip")
+					w.WriteHeader(test.status)
+					w.Write(gzipped(t, test.resp))
+					return
+				}
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			opts := append([]ClientOption{WithRootURL(ts.URL), WithHTTPClient(ts.Client())}, test.opts...)
+			c, err := New(testClientID, testToken, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res := testResultPlaceholder{}
+
+			err = c.post(testEndpoint, &res)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), test.wantErrDetail) {
+				t.Errorf("got: <%v>, want error containing: <%v>", err, test.wantErrDetail)
+			}
+
+			if gotEncoding != test.wantEncoding {
+				t.Errorf("got: <%v>, want: <%v>", gotEncoding, test.wantEncoding)
+			}
+
+			if res != test.wantRes {
+				t.Errorf("got: <%v>, want: <%v>", res, test.wantRes)
+			}
+		})
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		name         string
+		encoding     string
+		uncompressed bool
+		body         []byte
+		wantBody     string
+		wantErr      bool
+	}{
+		{"Compressed", "gzip", false, gzipped(t, testResult), testResult, false},
+		{"Compressed with uppercase encoding", "GZIP", false, gzipped(t, testResult), testResult, false},
+		{"Not compressed", "", false, []byte(testResult), testResult, false},
+		{"Already decompressed by transport", "gzip", true, []byte(testResult), testResult, false},
+		{"Empty compressed body", "gzip", false, nil, "", false},
+		{"Invalid compressed body", "gzip", false, []byte("not gzip"), "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{
+				Header:       http.Header{"Content-Encoding": []string{test.encoding}},
+				Body:         ioutil.NopCloser(bytes.NewReader(test.body)),
+				Uncompressed: test.uncompressed,
+			}
+
+			err := decompress(resp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if test.wantErr {
+				return
+			}
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.wantBody {
+				t.Errorf("got: <%s>, want: <%s>", b, test.wantBody)
+			}
+		})
+	}
+}
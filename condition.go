@@ -0,0 +1,391 @@
+package igdb
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// Errors returned by a Condition when building a where clause.
+var (
+	// ErrInvalidValue occurs when a Condition is given a value that cannot be
+	// represented in an apicalypse where clause.
+	ErrInvalidValue = errors.New("provided condition value cannot be represented in a query")
+	// ErrEmptyCondition occurs when a Condition has nothing to filter on.
+	ErrEmptyCondition = errors.New("provided condition is empty")
+)
+
+// Conjunctions used to combine multiple Conditions.
+const (
+	conjAnd = " & "
+	conjOr  = " | "
+)
+
+// Condition represents a single filter or a group of filters that make up the
+// where clause of an API call. Conditions are created with Field or Filter,
+// combined using And and Or, and negated using Not.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+type Condition struct {
+	field string
+	op    operator
+	vals  []string
+
+	conj  string
+	conds []*Condition
+
+	not *Condition
+
+	err error
+}
+
+// FieldBuilder creates Conditions for a single IGDB object field.
+type FieldBuilder struct {
+	name string
+}
+
+// Field returns a FieldBuilder used to create Conditions for the provided
+// field. Note that the field string must match an IGDB object's JSON field
+// tag exactly, not the Go struct field name.
+func Field(name string) *FieldBuilder {
+	return &FieldBuilder{name: name}
+}
+
+// Eq returns a Condition that checks if the field is equal to the provided value.
+func (fb *FieldBuilder) Eq(v interface{}) *Condition {
+	return fb.condition(OpEquals, v)
+}
+
+// Neq returns a Condition that checks if the field is not equal to the provided value.
+func (fb *FieldBuilder) Neq(v interface{}) *Condition {
+	return fb.condition(OpNotEquals, v)
+}
+
+// Gt returns a Condition that checks if the field is greater than the provided value.
+func (fb *FieldBuilder) Gt(v interface{}) *Condition {
+	return fb.condition(OpGreaterThan, v)
+}
+
+// Gte returns a Condition that checks if the field is greater than or equal to the provided value.
+func (fb *FieldBuilder) Gte(v interface{}) *Condition {
+	return fb.condition(OpGreaterThanEqual, v)
+}
+
+// Lt returns a Condition that checks if the field is less than the provided value.
+func (fb *FieldBuilder) Lt(v interface{}) *Condition {
+	return fb.condition(OpLessThan, v)
+}
+
+// Lte returns a Condition that checks if the field is less than or equal to the provided value.
+func (fb *FieldBuilder) Lte(v interface{}) *Condition {
+	return fb.condition(OpLessThanEqual, v)
+}
+
+// In returns a Condition that checks if the field matches any of the provided
+// values. When used on an array field, it checks if the array contains at
+// least one of the provided values.
+func (fb *FieldBuilder) In(vs ...interface{}) *Condition {
+	return fb.condition(OpContainsAtLeast, vs...)
+}
+
+// NotIn returns a Condition that checks if the field matches none of the
+// provided values.
+func (fb *FieldBuilder) NotIn(vs ...interface{}) *Condition {
+	return fb.condition(OpNotContainsAtLeast, vs...)
+}
+
+// Contains returns a Condition that checks if the array field contains the
+// provided value.
+func (fb *FieldBuilder) Contains(v interface{}) *Condition {
+	return fb.condition(OpContainsAll, v)
+}
+
+// ContainsAll returns a Condition that checks if the array field contains
+// every one of the provided values.
+func (fb *FieldBuilder) ContainsAll(vs ...interface{}) *Condition {
+	return fb.condition(OpContainsAll, vs...)
+}
+
+// ContainsExactly returns a Condition that checks if the array field contains
+// exactly the provided values and nothing else.
+func (fb *FieldBuilder) ContainsExactly(vs ...interface{}) *Condition {
+	return fb.condition(OpContainsExactly, vs...)
+}
+
+// IsNull returns a Condition that checks if the field has no value.
+func (fb *FieldBuilder) IsNull() *Condition {
+	return fb.condition(OpNull)
+}
+
+// NotNull returns a Condition that checks if the field has any value.
+func (fb *FieldBuilder) NotNull() *Condition {
+	return fb.condition(OpNotNull)
+}
+
+// Filter returns a Condition that applies the provided operator to the
+// provided field and value. Slices and arrays are used as a list of values,
+// so Filter("genres", OpContainsAny, []int{4, 5}) renders as genres = (4,5).
+// Strings are quoted and escaped, and times are converted to Unix timestamps.
+// Operators that take no values, such as OpNull, ignore the value.
+func Filter(field string, op operator, val interface{}) *Condition {
+	fb := Field(field)
+	if op.nullary() {
+		return fb.condition(op)
+	}
+
+	return fb.condition(op, val)
+}
+
+// condition returns a single field Condition using the provided operator
+// and values. Any error encountered while formatting the values is stored
+// and reported when the Condition is used as an Option.
+func (fb *FieldBuilder) condition(op operator, vs ...interface{}) *Condition {
+	c := &Condition{field: fb.name, op: op}
+
+	if blank.Is(fb.name) {
+		c.err = ErrEmptyFields
+		return c
+	}
+
+	if op.nullary() {
+		return c
+	}
+
+	if len(vs) <= 0 {
+		c.err = ErrEmptyFilterVals
+		return c
+	}
+
+	for _, v := range vs {
+		s, err := formatValues(v)
+		if err != nil {
+			c.err = err
+			return c
+		}
+		c.vals = append(c.vals, s...)
+	}
+
+	return c
+}
+
+// And returns a Condition that is satisfied only when both this Condition
+// and the other Condition are satisfied.
+func (c *Condition) And(other *Condition) *Condition {
+	return combine(conjAnd, c, other)
+}
+
+// Or returns a Condition that is satisfied when either this Condition or
+// the other Condition is satisfied.
+func (c *Condition) Or(other *Condition) *Condition {
+	return combine(conjOr, c, other)
+}
+
+// And returns a Condition that is satisfied only when all of the provided
+// Conditions are satisfied.
+func And(conds ...*Condition) *Condition {
+	return combine(conjAnd, conds...)
+}
+
+// Or returns a Condition that is satisfied when any of the provided
+// Conditions is satisfied.
+func Or(conds ...*Condition) *Condition {
+	return combine(conjOr, conds...)
+}
+
+// Not returns a Condition that is satisfied only when the provided
+// Condition is not satisfied.
+func Not(c *Condition) *Condition {
+	if c == nil {
+		return &Condition{err: ErrEmptyCondition}
+	}
+
+	return &Condition{not: c}
+}
+
+// combine joins the provided Conditions using the provided conjunction.
+// Groups using the same conjunction are flattened into a single group,
+// keeping any error of the flattened group.
+func combine(conj string, conds ...*Condition) *Condition {
+	grp := &Condition{conj: conj}
+
+	for _, c := range conds {
+		if c == nil {
+			grp.err = ErrEmptyCondition
+			continue
+		}
+
+		if c.conj == conj {
+			if c.err != nil && grp.err == nil {
+				grp.err = c.err
+			}
+			grp.conds = append(grp.conds, c.conds...)
+			continue
+		}
+
+		grp.conds = append(grp.conds, c)
+	}
+
+	return grp
+}
+
+// String returns the apicalypse where clause fragment represented by this
+// Condition. Grouped Conditions are wrapped in parentheses.
+func (c *Condition) String() string {
+	if c == nil {
+		return ""
+	}
+
+	if c.not != nil {
+		if c.not.conj != "" {
+			return "!" + c.not.String()
+		}
+		return "!(" + c.not.String() + ")"
+	}
+
+	if c.conj == "" {
+		return c.op.format(c.field, c.vals)
+	}
+
+	s := make([]string, len(c.conds))
+	for i, sub := range c.conds {
+		s[i] = sub.String()
+	}
+
+	return "(" + strings.Join(s, c.conj) + ")"
+}
+
+// Err returns the first error encountered while building this Condition.
+func (c *Condition) Err() error {
+	if c == nil {
+		return ErrEmptyCondition
+	}
+
+	if c.err != nil {
+		return c.err
+	}
+
+	if c.not != nil {
+		return c.not.Err()
+	}
+
+	if c.conj != "" && len(c.conds) <= 0 {
+		return ErrEmptyCondition
+	}
+
+	for _, sub := range c.conds {
+		if err := sub.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Option returns a functional option that filters the results from an API
+// call using this Condition. Like SetFilter, multiple Conditions may be
+// passed to a single API call.
+func (c *Condition) Option() Option {
+	return func() (apicalypse.Option, error) {
+		if err := c.Err(); err != nil {
+			return nil, errors.Wrap(err, "cannot use invalid condition")
+		}
+
+		return apicalypse.Where(c.String()), nil
+	}
+}
+
+// SetWhere is a functional option used to filter the results from an API
+// call using the provided Condition. It is equivalent to the Condition's
+// Option method.
+func SetWhere(c *Condition) Option {
+	return c.Option()
+}
+
+// formatValues returns the apicalypse representation of the provided value.
+// Slices and arrays are flattened into one representation per element.
+func formatValues(v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, ErrInvalidValue
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		if rv.Len() <= 0 {
+			return nil, ErrEmptyFilterVals
+		}
+
+		vals := make([]string, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			s, err := formatValue(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = s
+		}
+
+		return vals, nil
+	}
+
+	s, err := formatValue(rv)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{s}, nil
+}
+
+// formatValue returns the apicalypse representation of a single value.
+// Strings are quoted and escaped. Integer based types, including the
+// enumerated types in this package, are represented by their number.
+// Times and Timestamps are represented as Unix timestamps in seconds.
+func formatValue(rv reflect.Value) (string, error) {
+	if rv.IsValid() && rv.CanInterface() {
+		switch t := rv.Interface().(type) {
+		case filterValue:
+			return t.filterValue(), nil
+		case time.Time:
+			return TimestampFromTime(t), nil
+		case Timestamp:
+			return TimestampFromTime(t.Time), nil
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return quote(rv.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	case reflect.Interface, reflect.Ptr:
+		if rv.IsNil() {
+			return "", ErrInvalidValue
+		}
+		return formatValue(rv.Elem())
+	}
+
+	return "", errors.Wrapf(ErrInvalidValue, "unsupported type %s", rv.Type())
+}
+
+// quote returns the provided string as a double quoted apicalypse string
+// literal. Backslashes and double quotes are escaped.
+func quote(s string) string {
+	return `"` + escape(s) + `"`
+}
+
+// escape returns the provided string with its backslashes and double quotes
+// escaped for use inside an apicalypse string literal.
+func escape(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return s
+}
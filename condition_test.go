@@ -0,0 +1,346 @@
+package igdb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+func TestFieldBuilder(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cond    *Condition
+		wantStr string
+		wantErr error
+	}{
+		{"Equals int", Field("id").Eq(7346), "id = 7346", nil},
+		{"Not equals string", Field("name").Neq("Halo"), `name != "Halo"`, nil},
+		{"Greater than float", Field("rating").Gt(80.5), "rating > 80.5", nil},
+		{"Greater than or equal", Field("hypes").Gte(10), "hypes >= 10", nil},
+		{"Less than", Field("hypes").Lt(10), "hypes < 10", nil},
+		{"Less than or equal", Field("hypes").Lte(10), "hypes <= 10", nil},
+		{"Boolean", Field("developer").Eq(true), "developer = true", nil},
+		{"Enum", Field("category").Eq(PlatformConsole), "category = 1", nil},
+		{"GameCategory", Field("category").Eq(MainGame), "category = 0", nil},
+		{"GameStatus values", Field("status").In(StatusAlpha, StatusBeta), "status = (2,3)", nil},
+		{"In values", Field("platforms").In(48, 49), "platforms = (48,49)", nil},
+		{"In slice", Field("platforms").In([]int{48, 49}), "platforms = (48,49)", nil},
+		{"Not in", Field("platforms").NotIn(6), "platforms != (6)", nil},
+		{"Contains", Field("genres").Contains(5), "genres = [5]", nil},
+		{"Contains all", Field("genres").ContainsAll(5, 12), "genres = [5,12]", nil},
+		{"Contains exactly", Field("genres").ContainsExactly(5, 12), "genres = {5,12}", nil},
+		{"Is null", Field("cover").IsNull(), "cover = null", nil},
+		{"Not null", Field("cover").NotNull(), "cover != null", nil},
+		{"Time", Field("first_release_date").Gt(time.Unix(1554076800, 0)), "first_release_date > 1554076800", nil},
+		{"Timestamp", Field("created_at").Lte(Timestamp{time.Unix(1554076800, 0)}), "created_at <= 1554076800", nil},
+		{"Escaped string", Field("name").Eq(`Tom Clancy's "The Division"`), `name = "Tom Clancy's \"The Division\""`, nil},
+		{"Empty field", Field(" ").Eq(1), "", ErrEmptyFields},
+		{"No values", Field("platforms").In(), "", ErrEmptyFilterVals},
+		{"Empty slice", Field("platforms").In([]int{}), "", ErrEmptyFilterVals},
+		{"Nil value", Field("name").Eq(nil), "", ErrInvalidValue},
+		{"Unsupported value", Field("name").Eq(struct{}{}), "", ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cond.Err()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if test.cond.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.cond.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestCondition_Combine(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cond    *Condition
+		wantStr string
+		wantErr error
+	}{
+		{
+			"And",
+			Field("rating").Gt(80).And(Field("platforms").In(48)),
+			"(rating > 80 & platforms = (48))",
+			nil,
+		},
+		{
+			"Or",
+			Field("collection").Eq(5).Or(Field("collections").Contains(5)),
+			"(collection = 5 | collections = [5])",
+			nil,
+		},
+		{
+			"Flattened And",
+			Field("a").Eq(1).And(Field("b").Eq(2)).And(Field("c").Eq(3)),
+			"(a = 1 & b = 2 & c = 3)",
+			nil,
+		},
+		{
+			"Nested Or within And",
+			Field("a").Eq(1).And(Field("b").Eq(2).Or(Field("c").Eq(3))),
+			"(a = 1 & (b = 2 | c = 3))",
+			nil,
+		},
+		{
+			"Invalid nested condition",
+			Field("a").Eq(1).And(Field("").Eq(2)),
+			"",
+			ErrEmptyFields,
+		},
+		{
+			"Nil condition",
+			Field("a").Eq(1).Or(nil),
+			"",
+			ErrEmptyCondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cond.Err()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if test.cond.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.cond.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cond    *Condition
+		wantStr string
+		wantErr error
+	}{
+		{"Equals string", Filter("name", OpEquals, `Halo "CE"`), `name = "Halo \"CE\""`, nil},
+		{"Not equals bool", Filter("developer", OpNotEquals, false), "developer != false", nil},
+		{"Greater than time", Filter("first_release_date", OpGreaterThan, time.Unix(1554076800, 0)), "first_release_date > 1554076800", nil},
+		{"Less than int", Filter("hypes", OpLessThan, 10), "hypes < 10", nil},
+		{"Contains all int slice", Filter("genres", OpContainsAll, []int{5, 12}), "genres = [5,12]", nil},
+		{"Contains any int slice", Filter("platforms", OpContainsAny, []int{1, 2, 3}), "platforms = (1,2,3)", nil},
+		{"Contains exactly int slice", Filter("genres", OpContainsExactly, []int{5, 12}), "genres = {5,12}", nil},
+		{"Contains any string slice", Filter("slug", OpContainsAny, []string{"halo", "doom"}), `slug = ("halo","doom")`, nil},
+		{"Null", Filter("cover", OpNull, nil), "cover = null", nil},
+		{"Not null", Filter("cover", OpNotNull, nil), "cover != null", nil},
+		{"Empty field", Filter("", OpEquals, 1), "", ErrEmptyFields},
+		{"Nil value", Filter("name", OpEquals, nil), "", ErrInvalidValue},
+		{"Empty slice", Filter("platforms", OpContainsAny, []int{}), "", ErrEmptyFilterVals},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cond.Err()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if test.cond.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.cond.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestAndOrNot(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cond    *Condition
+		wantStr string
+		wantErr error
+	}{
+		{
+			"And",
+			And(Filter("rating", OpGreaterThan, 80), Filter("platforms", OpContainsAny, []int{48, 49}), Filter("cover", OpNotNull, nil)),
+			"(rating > 80 & platforms = (48,49) & cover != null)",
+			nil,
+		},
+		{
+			"Or",
+			Or(Filter("collection", OpEquals, 5), Filter("collections", OpContainsAll, []int{5})),
+			"(collection = 5 | collections = [5])",
+			nil,
+		},
+		{
+			"Nested Or within And",
+			And(Filter("a", OpEquals, 1), Or(Filter("b", OpEquals, 2), Filter("c", OpEquals, 3))),
+			"(a = 1 & (b = 2 | c = 3))",
+			nil,
+		},
+		{
+			"Nested And within Or",
+			Or(And(Filter("a", OpEquals, 1), Filter("b", OpEquals, 2)), And(Filter("c", OpEquals, 3), Filter("d", OpEquals, 4))),
+			"((a = 1 & b = 2) | (c = 3 & d = 4))",
+			nil,
+		},
+		{
+			"Flattened nested And",
+			And(And(Filter("a", OpEquals, 1), Filter("b", OpEquals, 2)), Filter("c", OpEquals, 3)),
+			"(a = 1 & b = 2 & c = 3)",
+			nil,
+		},
+		{
+			"Not single condition",
+			Not(Filter("genres", OpContainsAny, []int{5})),
+			"!(genres = (5))",
+			nil,
+		},
+		{
+			"Not group",
+			Not(Or(Filter("a", OpEquals, 1), Filter("b", OpEquals, "x"))),
+			`!(a = 1 | b = "x")`,
+			nil,
+		},
+		{
+			"Not within And",
+			And(Filter("rating", OpGreaterThan, 80), Not(Or(Filter("a", OpEquals, 1), Filter("b", OpEquals, 2)))),
+			"(rating > 80 & !(a = 1 | b = 2))",
+			nil,
+		},
+		{
+			"Empty And",
+			And(),
+			"",
+			ErrEmptyCondition,
+		},
+		{
+			"Not nil",
+			Not(nil),
+			"",
+			ErrEmptyCondition,
+		},
+		{
+			"Not invalid condition",
+			Not(Filter("a", OpEquals, nil)),
+			"",
+			ErrInvalidValue,
+		},
+		{
+			"Invalid operand in flattened And",
+			And(And(Filter("a", OpEquals, nil), Filter("x", OpEquals, 1)), Filter("y", OpEquals, 2)),
+			"",
+			ErrInvalidValue,
+		},
+		{
+			"Nil operand in flattened Or",
+			Or(Or(Filter("a", OpEquals, 1), nil), Filter("b", OpEquals, 2)),
+			"",
+			ErrEmptyCondition,
+		},
+		{
+			"Nil within Or",
+			Or(Filter("a", OpEquals, 1), nil),
+			"",
+			ErrEmptyCondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cond.Err()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if test.cond.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.cond.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestSetWhere(t *testing.T) {
+	var tests = []struct {
+		name      string
+		cond      *Condition
+		wantWhere string
+		wantErr   error
+	}{
+		{"Valid condition", And(Filter("rating", OpGreaterThanEqual, 75), Not(Filter("cover", OpNull, nil))), "where (rating >= 75 & !(cover = null))", nil},
+		{"Invalid condition", Or(Filter("rating", OpGreaterThanEqual, nil)), "", ErrInvalidValue},
+		{"Nil condition", nil, "", ErrEmptyCondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opt, err := SetWhere(test.cond)()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantWhere) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantWhere)
+			}
+		})
+	}
+}
+
+func TestCondition_Option(t *testing.T) {
+	var tests = []struct {
+		name      string
+		cond      *Condition
+		wantWhere string
+		wantErr   error
+	}{
+		{"Valid condition", Field("rating").Gte(75).And(Field("hypes").Gt(10)), "where (rating >= 75 & hypes > 10)", nil},
+		{"Invalid condition", Field("rating").Gte(nil), "", ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opt, err := test.cond.Option()()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantWhere) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantWhere)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -21,14 +22,23 @@ type CoverService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Covers, an error is returned.
 func (cs *CoverService) Get(id int, opts ...Option) (*Cover, error) {
+	return cs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Cover identified by the provided IGDB ID. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Covers, an error is
+// returned.
+func (cs *CoverService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Cover, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var cov []*Cover
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := cs.client.post(cs.end, &cov, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.postWithContext(ctx, cs.end, &cov, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Cover with ID %v", id)
 	}
@@ -41,6 +51,15 @@ func (cs *CoverService) Get(id int, opts ...Option) (*Cover, error) {
 // Any ID that does not match a Cover is ignored. If none of the IDs
 // match a Cover, an error is returned.
 func (cs *CoverService) List(ids []int, opts ...Option) ([]*Cover, error) {
+	return cs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Covers identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Cover is ignored. If none
+// of the IDs match a Cover, an error is returned.
+func (cs *CoverService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Cover, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -54,7 +73,7 @@ func (cs *CoverService) List(ids []int, opts ...Option) ([]*Cover, error) {
 	var cov []*Cover
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := cs.client.post(cs.end, &cov, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &cov, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Covers with IDs %v", ids)
 	}
@@ -62,13 +81,48 @@ func (cs *CoverService) List(ids []int, opts ...Option) ([]*Cover, error) {
 	return cov, nil
 }
 
+// ListByGame returns a list of Covers belonging to the Games identified by
+// the provided list of IGDB IDs. Provide functional options to sort, filter,
+// and paginate the results. If none of the Games have any Covers, an
+// error is returned.
+func (cs *CoverService) ListByGame(gameIDs []int, opts ...Option) ([]*Cover, error) {
+	if len(gameIDs) < 1 {
+		return nil, errors.Wrap(ErrEmptyIDs, "cannot list Covers without Game IDs")
+	}
+
+	for _, id := range gameIDs {
+		if id < 0 {
+			return nil, errors.Wrapf(ErrNegativeID, "cannot list Covers with Game ID %v", id)
+		}
+	}
+
+	var cov []*Cover
+
+	opts = append(opts, SetFilter("game", OpContainsAtLeast, sliceconv.Itoa(gameIDs)...))
+	err := cs.client.post(cs.end, &cov, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Covers with Game IDs %v", gameIDs)
+	}
+
+	return cov, nil
+}
+
 // Index returns an index of Covers based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no Covers can
 // be found using the provided options, an error is returned.
 func (cs *CoverService) Index(opts ...Option) ([]*Cover, error) {
+	return cs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Covers based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Covers can be found using the provided options, an error is
+// returned.
+func (cs *CoverService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Cover, error) {
 	var cov []*Cover
 
-	err := cs.client.post(cs.end, &cov, opts...)
+	err := cs.client.postWithContext(ctx, cs.end, &cov, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Covers")
 	}
@@ -80,7 +134,15 @@ func (cs *CoverService) Index(opts ...Option) ([]*Cover, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Covers to count.
 func (cs *CoverService) Count(opts ...Option) (int, error) {
-	ct, err := cs.client.getCount(cs.end, opts...)
+	return cs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Covers available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Covers to count.
+func (cs *CoverService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := cs.client.getCountWithContext(ctx, cs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Covers")
 	}
@@ -91,7 +153,14 @@ func (cs *CoverService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Cover object.
 func (cs *CoverService) Fields() ([]string, error) {
-	f, err := cs.client.getFields(cs.end)
+	return cs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Cover
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (cs *CoverService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := cs.client.getFieldsWithContext(ctx, cs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Cover fields")
 	}
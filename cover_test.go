@@ -49,7 +49,7 @@ func TestCoverService_Get(t *testing.T) {
 			defer ts.Close()
 
 			cov, err := c.Covers.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestCoverService_List(t *testing.T) {
 			defer ts.Close()
 
 			cov, err := c.Covers.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +107,68 @@ func TestCoverService_List(t *testing.T) {
 	}
 }
 
+func TestCoverService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testCoverList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Cover, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		ids        []int
+		opts       []Option
+		wantQry    string
+		wantCovers []*Cover
+		wantErr    error
+	}{
+		{"Valid response", testCoverList, []int{1942, 1020}, []Option{SetLimit(5)}, "where game = (1942,1020); limit 5; ", init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, "", nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1942}, nil, "where game = (1942); ", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1942}, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, "where game = (0,9999999); ", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			cov, err := c.Covers.ListByGame(test.ids, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(cov, test.wantCovers) {
+				t.Errorf("got: <%v>, \nwant: <%v>", cov, test.wantCovers)
+			}
+
+			if body != test.wantQry {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantQry)
+			}
+		})
+	}
+}
+
 func TestCoverService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testCoverList)
 	if err != nil {
@@ -140,7 +202,7 @@ func TestCoverService_Index(t *testing.T) {
 			defer ts.Close()
 
 			cov, err := c.Covers.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +233,7 @@ func TestCoverService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Covers.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +263,7 @@ func TestCoverService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Covers.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -0,0 +1,56 @@
+package igdb
+
+import (
+	"reflect"
+
+	"github.com/Henry-Sarabia/apicalypse"
+)
+
+// clauseAllowEmpty is the query clause set by AllowEmptyResults. It is
+// never written to the body of a request.
+const clauseAllowEmpty = "allow_empty"
+
+// AllowEmptyResults is a functional option used to treat an API call that
+// finds no results as a success. Instead of returning ErrNoResults, the call
+// returns an empty list and a nil error. This is useful for filtered Index and
+// List calls, where finding nothing is a normal outcome.
+//
+// AllowEmptyResults has no effect on calls that return a single object, such
+// as Get or SearchFirst, which still return ErrNoResults when nothing is
+// found. Calls without AllowEmptyResults are unaffected.
+func AllowEmptyResults() Option {
+	return func() (apicalypse.Option, error) {
+		return func(filters map[string]string) error {
+			filters[clauseAllowEmpty] = "true"
+			return nil
+		}, nil
+	}
+}
+
+// allowsEmpty returns true if any of the provided options is AllowEmptyResults.
+func allowsEmpty(opts []Option) bool {
+	for _, opt := range opts {
+		if _, ok := optionClauses(opt)[clauseAllowEmpty]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requireResults returns the provided options without AllowEmptyResults.
+// It is used by calls that return a single object, which must always find
+// a result.
+func requireResults(opts []Option) []Option {
+	return withoutClauses(opts, clauseAllowEmpty)
+}
+
+// setEmpty sets the slice pointed to by result, if any, to an empty slice.
+func setEmpty(result interface{}) {
+	if !isSlicePtr(result) {
+		return
+	}
+
+	rv := reflect.ValueOf(result).Elem()
+	rv.Set(reflect.MakeSlice(rv.Type(), 0, 0))
+}
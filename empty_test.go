@@ -0,0 +1,145 @@
+package igdb
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestAllowEmptyResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    string
+		call    func(c *Client) (interface{}, error)
+		wantLen int
+		wantErr error
+	}{
+		{
+			"Index without option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.Index() },
+			-1,
+			ErrNoResults,
+		},
+		{
+			"Index with option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.Index(AllowEmptyResults()) },
+			0,
+			nil,
+		},
+		{
+			"Index with option and results",
+			`[{"id": 1}, {"id": 2}]`,
+			func(c *Client) (interface{}, error) { return c.Games.Index(AllowEmptyResults()) },
+			2,
+			nil,
+		},
+		{
+			"Search with option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.Search("halo", AllowEmptyResults()) },
+			0,
+			nil,
+		},
+		{
+			"List with option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.List([]int{1, 2}, AllowEmptyResults()) },
+			0,
+			nil,
+		},
+		{
+			"Get with option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.Get(1, AllowEmptyResults()) },
+			-1,
+			ErrNoResults,
+		},
+		{
+			"SearchFirst with option",
+			"[]",
+			func(c *Client) (interface{}, error) { return c.Games.SearchFirst("halo", AllowEmptyResults()) },
+			-1,
+			ErrNoResults,
+		},
+		{
+			"Status Bad Request with option",
+			"[]",
+			func(c *Client) (interface{}, error) {
+				return c.Games.Index(AllowEmptyResults(), SetFilter("rating", OpGreaterThan, "oops"))
+			},
+			-1,
+			ErrBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+
+				if strings.Contains(string(b), clauseAllowEmpty) {
+					t.Errorf("got: <%s>, want query without <%v>", b, clauseAllowEmpty)
+				}
+
+				if strings.Contains(string(b), "oops") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			res, err := test.call(c)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantLen < 0 {
+				return
+			}
+
+			g, ok := res.([]*Game)
+			if !ok {
+				t.Fatalf("got: <%T>, want: <%T>", res, g)
+			}
+
+			if g == nil || len(g) != test.wantLen {
+				t.Errorf("got: <%v>, want non-nil slice of length: <%v>", g, test.wantLen)
+			}
+		})
+	}
+}
+
+func TestAllowEmptyResults_Iterator(t *testing.T) {
+	var reqs int
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		w.Write([]byte("[]"))
+	})
+	defer ts.Close()
+
+	it := c.Games.Iterate(AllowEmptyResults())
+	for it.Next() {
+		t.Errorf("got: <%v>, want no Games", it.Game())
+	}
+
+	if it.Err() != nil {
+		t.Errorf("got: <%v>, want: <nil>", it.Err())
+	}
+
+	if it.Next() {
+		t.Error("got: <true>, want: <false>")
+	}
+
+	if reqs != 1 {
+		t.Errorf("got: <%v> requests, want: <%v> request", reqs, 1)
+	}
+}
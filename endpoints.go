@@ -1,5 +1,11 @@
 package igdb
 
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
 type endpoint string
 
 // Public IGDB API endpoints
@@ -20,6 +26,7 @@ const (
 	EndpointGame                       endpoint = "games/"
 	EndpointGameEngine                 endpoint = "game_engines/"
 	EndpointGameEngineLogo             endpoint = "game_engine_logos/"
+	EndpointGameLocalization           endpoint = "game_localizations/"
 	EndpointGameMode                   endpoint = "game_modes/"
 	EndpointGameVersion                endpoint = "game_versions/"
 	EndpointGameVersionFeature         endpoint = "game_version_features/"
@@ -28,6 +35,7 @@ const (
 	EndpointGenre                      endpoint = "genres/"
 	EndpointInvolvedCompany            endpoint = "involved_companies/"
 	EndpointKeyword                    endpoint = "keywords/"
+	EndpointMultiQuery                 endpoint = "multiquery"
 	EndpointMultiplayerMode            endpoint = "multiplayer_modes/"
 	EndpointPlatform                   endpoint = "platforms/"
 	EndpointPlatformLogo               endpoint = "platform_logos/"
@@ -38,10 +46,14 @@ const (
 	EndpointPlayerPerspective          endpoint = "player_perspectives/"
 	EndpointPlatformFamily             endpoint = "product_families/"
 	EndpointPulse                      endpoint = "pulses/"
+	EndpointPulseGroup                 endpoint = "pulse_groups/"
+	EndpointPulseSource                endpoint = "pulse_sources/"
 	EndpointReleaseDate                endpoint = "release_dates/"
 	EndpointScreenshot                 endpoint = "screenshots/"
 	EndpointSearch                     endpoint = "search/"
+	EndpointStatus                     endpoint = "api_status/"
 	EndpointTheme                      endpoint = "themes/"
+	EndpointTimeToBeat                 endpoint = "game_time_to_beats/"
 	EndpointTitle                      endpoint = "titles/"
 	EndpointWebsite                    endpoint = "websites/"
 )
@@ -55,6 +67,13 @@ type Count struct {
 // getFields returns a list of fields that represent the
 // model of the data available at the given IGDB endpoint.
 func (c *Client) getFields(end endpoint) ([]string, error) {
+	return c.getFieldsWithContext(context.Background(), end)
+}
+
+// getFieldsWithContext returns a list of fields that represent the model of
+// the data available at the given IGDB endpoint. The request is canceled if
+// the provided context is canceled or its deadline is exceeded.
+func (c *Client) getFieldsWithContext(ctx context.Context, end endpoint) ([]string, error) {
 	req, err := c.request(end + "meta")
 	if err != nil {
 		return nil, err
@@ -62,26 +81,63 @@ func (c *Client) getFields(end endpoint) ([]string, error) {
 
 	var f []string
 
-	if err = c.send(req, &f); err != nil && err != ErrNoResults {
+	if err = c.send(req.WithContext(ctx), &f); err != nil && err != ErrNoResults {
+		if ctx.Err() != nil {
+			return nil, errors.Wrapf(ctx.Err(), "cannot get fields of '%s' endpoint", end)
+		}
 		return nil, err
 	}
 
 	return f, nil
 }
 
+// countClauses are the query clauses that cannot be used when counting results.
+var countClauses = []string{"fields", "limit", "offset", "sort"}
+
 // getCount returns the count of entities available for the given IGDB endpoint.
+// Options that set the fields, limit, offset, or order of the results are
+// rejected as they have no effect on the count.
 func (c *Client) getCount(end endpoint, opts ...Option) (int, error) {
+	return c.getCountWithContext(context.Background(), end, opts...)
+}
+
+// getCountWithContext returns the count of entities available for the given
+// IGDB endpoint. The request is canceled if the provided context is canceled
+// or its deadline is exceeded.
+func (c *Client) getCountWithContext(ctx context.Context, end endpoint, opts ...Option) (int, error) {
+	for _, opt := range opts {
+		clauses := optionClauses(opt)
+		for _, cl := range countClauses {
+			if _, ok := clauses[cl]; ok {
+				return 0, errors.Wrapf(ErrCountOption, "cannot count with %s option", cl)
+			}
+		}
+	}
+
 	req, err := c.request(end+"count", opts...)
 	if err != nil {
 		return 0, err
 	}
 
-	var ct Count
+	ctx, cancel := withTimeout(ctx, opts)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	var ct struct {
+		Count *int `json:"count"`
+	}
 
 	err = c.send(req, &ct)
 	if err != nil {
+		if ctx.Err() != nil {
+			return 0, errors.Wrapf(ctx.Err(), "cannot count '%s' endpoint", end)
+		}
 		return 0, err
 	}
 
-	return ct.Count, nil
+	if ct.Count == nil || *ct.Count < 0 {
+		return 0, ErrInvalidCount
+	}
+
+	return *ct.Count, nil
 }
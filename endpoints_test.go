@@ -29,7 +29,7 @@ func TestClient_GetFields(t *testing.T) {
 			defer ts.Close()
 
 			f, err := c.getFields(testEndpoint)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -45,14 +45,23 @@ func TestClient_GetCount(t *testing.T) {
 		name      string
 		status    int
 		resp      string
+		opts      []Option
 		wantCount int
 		wantErr   error
 	}{
-		{"OK status with regular response", http.StatusOK, `{"count": 1234}`, 1234, nil},
-		{"OK status with count of zero response", http.StatusOK, `{"count": 0}`, 0, nil},
-		{"OK status with empty response", http.StatusOK, "", 0, errInvalidJSON},
-		{"Bad status with empty response", http.StatusBadRequest, "", 0, ErrBadRequest},
-		{"Not found status with error response", http.StatusNotFound, testErrNotFound, 0, ServerError{Status: 404, Msg: "status not found"}},
+		{"OK status with regular response", http.StatusOK, `{"count": 1234}`, nil, 1234, nil},
+		{"OK status with count of zero response", http.StatusOK, `{"count": 0}`, nil, 0, nil},
+		{"OK status with filter option", http.StatusOK, `{"count": 12}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 12, nil},
+		{"OK status with missing count response", http.StatusOK, `{"total": 1234}`, nil, 0, ErrInvalidCount},
+		{"OK status with negative count response", http.StatusOK, `{"count": -1}`, nil, 0, ErrInvalidCount},
+		{"OK status with empty response", http.StatusOK, "", nil, 0, errInvalidJSON},
+		{"Fields option", http.StatusOK, `{"count": 1234}`, []Option{SetFields("name")}, 0, ErrCountOption},
+		{"Limit option", http.StatusOK, `{"count": 1234}`, []Option{SetLimit(10)}, 0, ErrCountOption},
+		{"Offset option", http.StatusOK, `{"count": 1234}`, []Option{SetOffset(10)}, 0, ErrCountOption},
+		{"Order option", http.StatusOK, `{"count": 1234}`, []Option{SetOrder("name", OrderAscending)}, 0, ErrCountOption},
+		{"Composed order option", http.StatusOK, `{"count": 1234}`, []Option{ComposeOptions(SetFilter("hypes", OpGreaterThan, "75"), SetOrder("name", OrderAscending))}, 0, ErrCountOption},
+		{"Bad status with empty response", http.StatusBadRequest, "", nil, 0, ErrBadRequest},
+		{"Not found status with error response", http.StatusNotFound, testErrNotFound, nil, 0, ServerError{Status: 404, Msg: "status not found"}},
 	}
 
 	for _, test := range tests {
@@ -60,8 +69,8 @@ func TestClient_GetCount(t *testing.T) {
 			ts, c := testServerString(test.status, test.resp)
 			defer ts.Close()
 
-			count, err := c.getCount(testEndpoint)
-			if errors.Cause(err) != test.wantErr {
+			count, err := c.getCount(testEndpoint, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -12,10 +13,18 @@ import (
 var (
 	// ErrNegativeID occurs when a negative ID is used as an argument in an API call.
 	ErrNegativeID = errors.New("ID cannot be negative")
+	// ErrNonPositiveID occurs when a zero or negative ID is used as an argument where only positive IDs are valid.
+	ErrNonPositiveID = errors.New("ID must be positive")
 	// ErrEmptyIDs occurs when a List function is called without a populated int slice.
 	ErrEmptyIDs = errors.New("IDs argument empty")
+	// ErrEmptyUID occurs when an external ID lookup is called without a UID.
+	ErrEmptyUID = errors.New("UID argument empty")
 	// ErrNoResults occurs when the IGDB returns an empty array, void of results.
 	ErrNoResults = errors.New("results are empty")
+	// ErrCountOption occurs when an option that sets the fields, limit, offset, or order is used to count results.
+	ErrCountOption = errors.New("option cannot be used when counting results")
+	// ErrInvalidCount occurs when the IGDB responds with a missing or negative count.
+	ErrInvalidCount = errors.New("count is missing or negative")
 	// errInvalidJSON occurs when encountering an unexpected end of JSON input.
 	errInvalidJSON = errors.New("invalid JSON")
 )
@@ -73,22 +82,94 @@ func (e ServerError) Temporary() bool {
 	return e.Temp
 }
 
-// checkResponse checks the provided HTTP response
-// for errors returned by the IGDB.
+// APIError contains the details of an error response returned by the IGDB.
+// An APIError matches the ServerError with the same status when compared
+// using errors.Is, so errors.Is(err, ErrBadRequest) reports whether the
+// IGDB rejected a request as malformed.
+type APIError struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Msg describes the error.
+	Msg string
+	// Body is the raw body of the response.
+	Body string
+	// Details contains the errors reported in the body of the response, if any.
+	Details []ErrorDetail
+	// RetryAfter is the duration the IGDB asked to wait before retrying a
+	// rate limited request. Zero if no Retry-After header was provided.
+	RetryAfter time.Duration
+	// Temp is true if the error is temporary.
+	Temp bool
+}
+
+// ErrorDetail describes a single error reported by the IGDB,
+// such as a syntax error in a query.
+type ErrorDetail struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Cause  string `json:"cause"`
+}
+
+// Error formats the APIError and fulfills the error interface.
+func (e *APIError) Error() string {
+	msg := "igdb server error: status: " + strconv.Itoa(e.Status) + " message: " + e.Msg
+
+	for _, d := range e.Details {
+		msg += "; " + d.Title
+		if d.Cause != "" {
+			msg += ": " + d.Cause
+		}
+	}
+
+	return msg
+}
+
+// Temporary returns true if the error is temporary.
+func (e *APIError) Temporary() bool {
+	return e.Temp
+}
+
+// Is reports whether the target is a ServerError or
+// APIError with the same status as the APIError.
+func (e *APIError) Is(target error) bool {
+	switch t := target.(type) {
+	case ServerError:
+		return t.Status == e.Status
+	case *APIError:
+		return t.Status == e.Status
+	}
+
+	return false
+}
+
+// IsRateLimited returns true if the error was caused
+// by the IGDB responding with a Too Many Requests status.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrManyRequests)
+}
+
+// IsUnauthorized returns true if the error was caused by the IGDB
+// responding with an Unauthorized or Forbidden status.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden)
+}
+
+// serverErrors maps the error status codes with a known
+// meaning to their corresponding ServerError.
+var serverErrors = map[int]ServerError{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusInternalServerError: ErrInternalError,
+	http.StatusTooManyRequests:     ErrManyRequests,
+}
+
+// checkResponse checks the provided HTTP response for errors returned by
+// the IGDB. Error responses are returned as an *APIError populated with the
+// error payload sent by the IGDB.
 func checkResponse(resp *http.Response) error {
-	switch resp.StatusCode {
-	case http.StatusOK:
+	if resp.StatusCode == http.StatusOK {
 		return nil
-	case http.StatusBadRequest:
-		return ErrBadRequest
-	case http.StatusUnauthorized:
-		return ErrUnauthorized
-	case http.StatusForbidden:
-		return ErrForbidden
-	case http.StatusInternalServerError:
-		return ErrInternalError
-	case http.StatusTooManyRequests:
-		return ErrManyRequests
 	}
 
 	b, err := ioutil.ReadAll(resp.Body)
@@ -96,35 +177,32 @@ func checkResponse(resp *http.Response) error {
 		return err
 	}
 
-	var e ServerError
-
-	err = json.Unmarshal(b, &e)
-	if err != nil {
-		return errors.Wrap(err, "could not unmarshal server error message")
+	e := &APIError{
+		Status: resp.StatusCode,
+		Msg:    http.StatusText(resp.StatusCode),
+		Body:   string(b),
 	}
 
-	return e
-}
+	if json.Unmarshal(b, &e.Details) != nil {
+		e.Details = nil
 
-// Byte representations of ASCII characters. Used for empty result checks.
-const (
-	// openBracketASCII represents the ASCII code for an open bracket.
-	openBracketASCII = 91
-	// closedBracketASCII represents the ASCII code for a closed bracket.
-	closedBracketASCII = 93
-)
+		var se ServerError
+		if json.Unmarshal(b, &se) == nil {
+			if se.Msg != "" {
+				e.Msg = se.Msg
+			}
+			e.Temp = se.Temp
+		}
+	}
 
-// isBracketPair returns true if the provided slice of bytes is equivalent to
-// an open and closed bracket pair in byte representation. Otherwise, false is returned.
-// Used primarily to check if the results of an API call are an empty array.
-func isBracketPair(b []byte) bool {
-	if len(b) != 2 {
-		return false
+	if se, ok := serverErrors[resp.StatusCode]; ok {
+		e.Msg = se.Msg
+		e.Temp = se.Temp
 	}
 
-	if b[0] == openBracketASCII && b[1] == closedBracketASCII {
-		return true
+	if resp.StatusCode == http.StatusTooManyRequests {
+		e.RetryAfter = retryAfter(resp)
 	}
 
-	return false
+	return e
 }
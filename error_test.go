@@ -3,8 +3,10 @@ package igdb
 import (
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -16,6 +18,16 @@ const testErrNotFound = `
 }
 `
 
+const testErrSyntax = `
+[
+	{
+		"title": "Syntax Error",
+		"status": 400,
+		"cause": "Missing ';' at end of query"
+	}
+]
+`
+
 func TestCheckResponse(t *testing.T) {
 	var tests = []struct {
 		name    string
@@ -40,39 +52,141 @@ func TestCheckResponse(t *testing.T) {
 
 			err := checkResponse(resp)
 
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 		})
 	}
 }
 
-func TestIsBracketPair(t *testing.T) {
-	tests := []struct {
-		name     string
-		b        []byte
-		wantBool bool
+func TestCheckResponse_APIError(t *testing.T) {
+	var tests = []struct {
+		name    string
+		code    int
+		header  http.Header
+		body    string
+		wantErr *APIError
+	}{
+		{
+			"Error details",
+			http.StatusBadRequest,
+			nil,
+			testErrSyntax,
+			&APIError{
+				Status:  http.StatusBadRequest,
+				Msg:     ErrBadRequest.Msg,
+				Body:    testErrSyntax,
+				Details: []ErrorDetail{{Title: "Syntax Error", Status: 400, Cause: "Missing ';' at end of query"}},
+			},
+		},
+		{
+			"Error message",
+			http.StatusNotFound,
+			nil,
+			testErrNotFound,
+			&APIError{
+				Status: http.StatusNotFound,
+				Msg:    "status not found",
+				Body:   testErrNotFound,
+			},
+		},
+		{
+			"Unexpected body",
+			http.StatusBadGateway,
+			nil,
+			"bad gateway",
+			&APIError{
+				Status: http.StatusBadGateway,
+				Msg:    http.StatusText(http.StatusBadGateway),
+				Body:   "bad gateway",
+			},
+		},
+		{
+			"Retry-After",
+			http.StatusTooManyRequests,
+			http.Header{"Retry-After": []string{"3"}},
+			"",
+			&APIError{
+				Status:     http.StatusTooManyRequests,
+				Msg:        ErrManyRequests.Msg,
+				RetryAfter: 3 * time.Second,
+				Temp:       true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: test.code,
+				Header: test.header,
+				Body:   ioutil.NopCloser(strings.NewReader(test.body)),
+			}
+
+			err := checkResponse(resp)
+
+			var got *APIError
+			if !errors.As(err, &got) {
+				t.Fatalf("got: <%T>, want: <%T>", err, test.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, test.wantErr) {
+				t.Errorf("got: <%#v>, want: <%#v>", got, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsRateLimited(t *testing.T) {
+	var tests = []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil error", nil, false},
+		{"Rate limited", &APIError{Status: http.StatusTooManyRequests}, true},
+		{"Wrapped rate limited", errors.Wrap(&APIError{Status: http.StatusTooManyRequests}, "wrapped"), true},
+		{"Sentinel", ErrManyRequests, true},
+		{"Bad request", &APIError{Status: http.StatusBadRequest}, false},
+		{"Unrelated error", ErrNoResults, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsRateLimited(test.err); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	var tests = []struct {
+		name string
+		err  error
+		want bool
 	}{
-		{"Nil slice", nil, false},
-		{"Empty byte slice", []byte{}, false},
-		{"Single open bracket", []byte{91}, false},
-		{"Single closed bracket", []byte{93}, false},
-		{"Double open bracket", []byte{91, 91}, false},
-		{"Double closed bracket", []byte{93, 93}, false},
-		{"Triple open bracket", []byte{91, 91, 91}, false},
-		{"Triple closed bracket", []byte{93, 93, 93}, false},
-		{"Reversed bracket pair", []byte{93, 92}, false},
-		{"Proper bracket pair with leading bracket", []byte{91, 91, 93}, false},
-		{"Proper bracket pair with trailing bracket", []byte{91, 93, 93}, false},
-		{"Double proper bracket pair", []byte{91, 93, 91, 93}, false},
-		{"Proper bracket pair", []byte{91, 93}, true},
+		{"Nil error", nil, false},
+		{"Unauthorized", &APIError{Status: http.StatusUnauthorized}, true},
+		{"Forbidden", &APIError{Status: http.StatusForbidden}, true},
+		{"Wrapped unauthorized", errors.Wrap(&APIError{Status: http.StatusUnauthorized}, "wrapped"), true},
+		{"Rate limited", &APIError{Status: http.StatusTooManyRequests}, false},
+		{"Unrelated error", ErrNoResults, false},
 	}
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := isBracketPair(test.b)
-			if got != test.wantBool {
-				t.Errorf("got: <%v>, want: <%v>", got, test.wantBool)
+			if got := IsUnauthorized(test.err); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
 			}
 		})
 	}
 }
+
+func TestSentinelErrors_Wrapped(t *testing.T) {
+	for _, sentinel := range []error{ErrNoResults, ErrNegativeID} {
+		err := errors.Wrap(sentinel, "wrapped")
+		if !errors.Is(err, sentinel) {
+			t.Errorf("errors.Is(%v, %v) = false, want true", err, sentinel)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package igdb
 
 import (
+	"context"
 	"strconv"
 
+	"github.com/Henry-Sarabia/blank"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 )
@@ -15,11 +17,14 @@ import (
 type ExternalGame struct {
 	ID        int                  `json:"id"`
 	Category  ExternalGameCategory `json:"category"`
-	CreatedAt int                  `json:"created_at"`
+	Countries []int                `json:"countries"`
+	CreatedAt Timestamp            `json:"created_at"`
 	Game      int                  `json:"game"`
+	Media     ExternalGameMedia    `json:"media"`
 	Name      string               `json:"name"`
+	Platform  int                  `json:"platform"`
 	UID       string               `json:"uid"`
-	UpdatedAt int                  `json:"updated_at"`
+	UpdatedAt Timestamp            `json:"updated_at"`
 	Url       string               `json:"url"`
 	Year      int                  `json:"year"`
 }
@@ -27,7 +32,7 @@ type ExternalGame struct {
 // ExternalGameCategory speficies an external game, platform, or media service.
 type ExternalGameCategory int
 
-//go:generate stringer -type=ExternalGameCategory
+//go:generate stringer -type=ExternalGameCategory,ExternalGameMedia
 
 // Expected ExternalGameCategory enums from the IGDB.
 const (
@@ -46,6 +51,37 @@ const (
 	ExternalApple
 	ExternalTwitch
 	ExternalAndroid
+	_
+	_
+	_
+	_
+	ExternalAmazonASIN
+	_
+	ExternalAmazonLuna
+	ExternalAmazonADG
+	_
+	_
+	ExternalEpicGameStore
+	_
+	ExternalOculus
+	ExternalUtomik
+	ExternalItchIO
+	ExternalXboxMarketplace
+	ExternalKartridge
+	_
+	_
+	_
+	ExternalPlayStationStoreUS
+	ExternalFocusEntertainment
+)
+
+// ExternalGameMedia specifies the medium an external game is distributed on.
+type ExternalGameMedia int
+
+// Expected ExternalGameMedia enums from the IGDB.
+const (
+	ExternalMediaDigital ExternalGameMedia = iota + 1
+	ExternalMediaPhysical
 )
 
 // ExternalGameService handles all the API calls for the IGDB ExternalGame endpoint.
@@ -55,14 +91,23 @@ type ExternalGameService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any ExternalGames, an error is returned.
 func (es *ExternalGameService) Get(id int, opts ...Option) (*ExternalGame, error) {
+	return es.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single ExternalGame identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any ExternalGames,
+// an error is returned.
+func (es *ExternalGameService) GetWithContext(ctx context.Context, id int, opts ...Option) (*ExternalGame, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var ext []*ExternalGame
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := es.client.post(es.end, &ext, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := es.client.postWithContext(ctx, es.end, &ext, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get ExternalGame with ID %v", id)
 	}
@@ -75,6 +120,15 @@ func (es *ExternalGameService) Get(id int, opts ...Option) (*ExternalGame, error
 // Any ID that does not match a ExternalGame is ignored. If none of the IDs
 // match a ExternalGame, an error is returned.
 func (es *ExternalGameService) List(ids []int, opts ...Option) ([]*ExternalGame, error) {
+	return es.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of ExternalGames identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a ExternalGame is ignored.
+// If none of the IDs match a ExternalGame, an error is returned.
+func (es *ExternalGameService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*ExternalGame, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -88,7 +142,7 @@ func (es *ExternalGameService) List(ids []int, opts ...Option) ([]*ExternalGame,
 	var ext []*ExternalGame
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := es.client.post(es.end, &ext, opts...)
+	err := es.client.postWithContext(ctx, es.end, &ext, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get ExternalGames with IDs %v", ids)
 	}
@@ -96,13 +150,67 @@ func (es *ExternalGameService) List(ids []int, opts ...Option) ([]*ExternalGame,
 	return ext, nil
 }
 
+// ByStoreID returns the list of ExternalGames identified by the provided
+// ExternalGameCategory and store-specific UID, such as a Steam app ID. Use the
+// Game field of the results to retrieve the matching IGDB Games. Provide
+// functional options to sort, filter, and paginate the results. If no
+// ExternalGames match the category and UID, an error is returned.
+func (es *ExternalGameService) ByStoreID(category ExternalGameCategory, uid string, opts ...Option) ([]*ExternalGame, error) {
+	if blank.Is(uid) {
+		return nil, ErrEmptyUID
+	}
+
+	var ext []*ExternalGame
+
+	opts = append(opts,
+		SetFilter("category", OpEquals, strconv.Itoa(int(category))),
+		SetFilter("uid", OpEquals, quote(uid)),
+	)
+	err := es.client.post(es.end, &ext, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ExternalGames with %v UID %s", category, uid)
+	}
+
+	return ext, nil
+}
+
+// ListByGame returns the list of ExternalGames belonging to the Game identified by
+// the provided IGDB ID. Up to 500 ExternalGames are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no ExternalGames, an error is returned.
+func (es *ExternalGameService) ListByGame(gameID int, opts ...Option) ([]*ExternalGame, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var ext []*ExternalGame
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := es.client.post(es.end, &ext, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ExternalGames with Game ID %v", gameID)
+	}
+
+	return ext, nil
+}
+
 // Index returns an index of ExternalGames based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no ExternalGames can
 // be found using the provided options, an error is returned.
 func (es *ExternalGameService) Index(opts ...Option) ([]*ExternalGame, error) {
+	return es.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of ExternalGames based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no ExternalGames can be found using the provided options, an
+// error is returned.
+func (es *ExternalGameService) IndexWithContext(ctx context.Context, opts ...Option) ([]*ExternalGame, error) {
 	var ext []*ExternalGame
 
-	err := es.client.post(es.end, &ext, opts...)
+	err := es.client.postWithContext(ctx, es.end, &ext, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of ExternalGames")
 	}
@@ -114,7 +222,15 @@ func (es *ExternalGameService) Index(opts ...Option) ([]*ExternalGame, error) {
 // Provide the SetFilter functional option if you need to filter
 // which ExternalGames to count.
 func (es *ExternalGameService) Count(opts ...Option) (int, error) {
-	ct, err := es.client.getCount(es.end, opts...)
+	return es.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of ExternalGames available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which ExternalGames to count.
+func (es *ExternalGameService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := es.client.getCountWithContext(ctx, es.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count ExternalGames")
 	}
@@ -125,7 +241,14 @@ func (es *ExternalGameService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB ExternalGame object.
 func (es *ExternalGameService) Fields() ([]string, error) {
-	f, err := es.client.getFields(es.end)
+	return es.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// ExternalGame object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (es *ExternalGameService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := es.client.getFieldsWithContext(ctx, es.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get ExternalGame fields")
 	}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestExternalGameService_Get(t *testing.T) {
 			defer ts.Close()
 
 			ext, err := c.ExternalGames.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestExternalGameService_List(t *testing.T) {
 			defer ts.Close()
 
 			ext, err := c.ExternalGames.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +108,133 @@ func TestExternalGameService_List(t *testing.T) {
 	}
 }
 
+func TestExternalGameService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testExternalGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*ExternalGame, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name              string
+		file              string
+		id                int
+		opts              []Option
+		wantLim           string
+		wantExternalGames []*ExternalGame
+		wantErr           error
+	}{
+		{"Valid response", testExternalGameList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testExternalGameList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			ext, err := c.ExternalGames.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ext, test.wantExternalGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ext, test.wantExternalGames)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
+func TestExternalGameService_ByStoreID(t *testing.T) {
+	f, err := ioutil.ReadFile(testExternalGameGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*ExternalGame, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name              string
+		file              string
+		cat               ExternalGameCategory
+		uid               string
+		opts              []Option
+		wantBody          []string
+		wantExternalGames []*ExternalGame
+		wantErr           error
+	}{
+		{"Valid response", testExternalGameGet, ExternalSteam, "650350", nil, []string{"category = 1", `uid = "650350"`}, init, nil},
+		{"Empty UID", testFileEmpty, ExternalSteam, "", nil, nil, nil, ErrEmptyUID},
+		{"Whitespace UID", testFileEmpty, ExternalSteam, "  ", nil, nil, nil, ErrEmptyUID},
+		{"Empty response", testFileEmpty, ExternalGOG, "1207658924", nil, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, ExternalGOG, "1207658924", []Option{SetOffset(-99999)}, nil, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, ExternalEpicGameStore, "fortnite", nil, []string{"category = 26"}, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			ext, err := c.ExternalGames.ByStoreID(test.cat, test.uid, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ext, test.wantExternalGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ext, test.wantExternalGames)
+			}
+
+			for _, want := range test.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
 func TestExternalGameService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testExternalGameList)
 	if err != nil {
@@ -140,7 +268,7 @@ func TestExternalGameService_Index(t *testing.T) {
 			defer ts.Close()
 
 			ext, err := c.ExternalGames.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +299,7 @@ func TestExternalGameService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.ExternalGames.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +329,7 @@ func TestExternalGameService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.ExternalGames.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
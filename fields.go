@@ -0,0 +1,108 @@
+package igdb
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// ErrNotStruct occurs when a value other than a struct or a pointer to a
+// struct is used to list fields.
+var ErrNotStruct = errors.New("provided value is not a struct")
+
+// fieldCache holds the JSON field names of each struct type listed by Fields.
+var fieldCache sync.Map
+
+// Fields returns the IGDB field names of the provided struct, or pointer to a
+// struct, as given by the JSON tags of its fields. Tag options such as
+// omitempty are ignored, and fields without a JSON tag or tagged "-" are
+// skipped. The fields of embedded structs are included as if they were
+// declared in the outer struct. Nested structs are listed by their own field
+// name, since expanded subfields are not supported. The fields of each type
+// are only computed once.
+func Fields(v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, errors.Wrap(ErrNotStruct, "cannot list fields of nil")
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, errors.Wrapf(ErrNotStruct, "cannot list fields of %s", t)
+	}
+
+	return cachedStructFields(t), nil
+}
+
+// SetFieldsFor is a functional option used to request exactly the fields
+// that the provided struct, or pointer to a struct, can hold. The fields are
+// listed using Fields. For example, SetFieldsFor(igdb.Game{}) requests every
+// field of a Game. If the struct has no fields to request, an error is returned.
+func SetFieldsFor(v interface{}) Option {
+	return func() (apicalypse.Option, error) {
+		fields, err := Fields(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "SetFieldsFor cannot list fields")
+		}
+
+		return SetFields(fields...)()
+	}
+}
+
+// cachedStructFields returns a copy of the JSON field names of the provided
+// struct type, computing and caching them on first use.
+func cachedStructFields(t reflect.Type) []string {
+	f, ok := fieldCache.Load(t)
+	if !ok {
+		f, _ = fieldCache.LoadOrStore(t, structFields(t))
+	}
+
+	return append([]string(nil), f.([]string)...)
+}
+
+// structFields returns the JSON field names of the provided struct type in
+// the order they are declared. The fields of embedded structs are included
+// as if they were declared in the outer struct. Fields without a JSON tag or
+// ignored by JSON are skipped, as are repeated field names.
+func structFields(t reflect.Type) []string {
+	seen := make(map[string]bool)
+	return appendStructFields(nil, t, seen)
+}
+
+// appendStructFields appends the JSON field names of the provided struct
+// type that are not yet seen to the provided slice and returns it.
+func appendStructFields(fields []string, t reflect.Type, seen map[string]bool) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			fields = appendStructFields(fields, ft, seen)
+			continue
+		}
+
+		if name == "" || name == "-" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		fields = append(fields, name)
+	}
+
+	return fields
+}
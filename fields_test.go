@@ -0,0 +1,149 @@
+package igdb
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+func TestStructFields(t *testing.T) {
+	type testEmbedded struct {
+		Inner string `json:"inner"`
+	}
+
+	var tests = []struct {
+		name       string
+		typ        reflect.Type
+		wantFields []string
+	}{
+		{"Tagged fields", reflect.TypeOf(struct {
+			ID   int    `json:"id"`
+			Name string `json:"name,omitempty"`
+		}{}), []string{"id", "name"}},
+		{"Skipped fields", reflect.TypeOf(struct {
+			ID       int `json:"id"`
+			Ignored  int `json:"-"`
+			Untagged int
+		}{}), []string{"id"}},
+		{"Embedded struct", reflect.TypeOf(struct {
+			ID int `json:"id"`
+			testEmbedded
+		}{}), []string{"id", "inner"}},
+		{"Embedded struct pointer", reflect.TypeOf(struct {
+			ID int `json:"id"`
+			*testEmbedded
+		}{}), []string{"id", "inner"}},
+		{"Tagged embedded struct", reflect.TypeOf(struct {
+			ID           int `json:"id"`
+			testEmbedded `json:"embedded"`
+		}{}), []string{"id", "embedded"}},
+		{"Nested struct", reflect.TypeOf(struct {
+			ID    int           `json:"id"`
+			Cover *testEmbedded `json:"cover"`
+		}{}), []string{"id", "cover"}},
+		{"Repeated field", reflect.TypeOf(struct {
+			Inner string `json:"inner"`
+			testEmbedded
+		}{}), []string{"inner"}},
+		{"Pointer", reflect.TypeOf(&Image{}), []string{"alpha_channel", "animated", "height", "image_id", "url", "width"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields := structFields(test.typ)
+			if !reflect.DeepEqual(fields, test.wantFields) {
+				t.Errorf("got: <%v>, want: <%v>", fields, test.wantFields)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	type testFields struct {
+		ID   int    `json:"id"`
+		Name string `json:"name,omitempty"`
+	}
+
+	var tests = []struct {
+		name       string
+		v          interface{}
+		wantFields []string
+		wantErr    error
+	}{
+		{"Struct", testFields{}, []string{"id", "name"}, nil},
+		{"Pointer to struct", &testFields{}, []string{"id", "name"}, nil},
+		{"Nil pointer to struct", (*testFields)(nil), []string{"id", "name"}, nil},
+		{"Nil", nil, nil, ErrNotStruct},
+		{"Non-struct", 123, nil, ErrNotStruct},
+		{"Slice of structs", []testFields{}, nil, ErrNotStruct},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields, err := Fields(test.v)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fields, test.wantFields) {
+				t.Errorf("got: <%v>, want: <%v>", fields, test.wantFields)
+			}
+		})
+	}
+}
+
+func TestFields_Copy(t *testing.T) {
+	fields, err := Fields(Image{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields[0] = "modified"
+
+	fields, err = Fields(Image{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields[0] != "alpha_channel" {
+		t.Errorf("got: <%v>, want: <%v>", fields[0], "alpha_channel")
+	}
+}
+
+func TestSetFieldsFor(t *testing.T) {
+	var tests = []struct {
+		name       string
+		v          interface{}
+		wantFields string
+		wantErr    error
+	}{
+		{"Struct", Image{}, "alpha_channel,animated,height,image_id,url,width", nil},
+		{"Pointer to struct", &Image{}, "alpha_channel,animated,height,image_id,url,width", nil},
+		{"Struct without fields", struct{}{}, "", ErrEmptyFields},
+		{"Non-struct", "Image", "", ErrNotStruct},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := SetFieldsFor(test.v)()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFields) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFields)
+			}
+		})
+	}
+}
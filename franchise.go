@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/Henry-Sarabia/sliceconv"
@@ -12,12 +13,13 @@ import (
 // Franchise is a list of video game franchises such as Star Wars.
 // For more information visit: https://api-docs.igdb.com/#franchise
 type Franchise struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	Url       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Games     []int     `json:"games"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	Url       string    `json:"url"`
 }
 
 // FranchiseService handles all the API calls for the IGDB Franchise endpoint.
@@ -27,14 +29,23 @@ type FranchiseService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Franchises, an error is returned.
 func (fs *FranchiseService) Get(id int, opts ...Option) (*Franchise, error) {
+	return fs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Franchise identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Franchises, an error
+// is returned.
+func (fs *FranchiseService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Franchise, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var fr []*Franchise
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := fs.client.post(fs.end, &fr, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := fs.client.postWithContext(ctx, fs.end, &fr, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Franchise with ID %v", id)
 	}
@@ -47,6 +58,15 @@ func (fs *FranchiseService) Get(id int, opts ...Option) (*Franchise, error) {
 // Any ID that does not match a Franchise is ignored. If none of the IDs
 // match a Franchise, an error is returned.
 func (fs *FranchiseService) List(ids []int, opts ...Option) ([]*Franchise, error) {
+	return fs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Franchises identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Franchise is ignored. If
+// none of the IDs match a Franchise, an error is returned.
+func (fs *FranchiseService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Franchise, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -60,7 +80,7 @@ func (fs *FranchiseService) List(ids []int, opts ...Option) ([]*Franchise, error
 	var fr []*Franchise
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := fs.client.post(fs.end, &fr, opts...)
+	err := fs.client.postWithContext(ctx, fs.end, &fr, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Franchises with IDs %v", ids)
 	}
@@ -72,9 +92,18 @@ func (fs *FranchiseService) List(ids []int, opts ...Option) ([]*Franchise, error
 // options used to sort, filter, and paginate the results. If no Franchises can
 // be found using the provided options, an error is returned.
 func (fs *FranchiseService) Index(opts ...Option) ([]*Franchise, error) {
+	return fs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Franchises based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Franchises can be found using the provided options, an error
+// is returned.
+func (fs *FranchiseService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Franchise, error) {
 	var fr []*Franchise
 
-	err := fs.client.post(fs.end, &fr, opts...)
+	err := fs.client.postWithContext(ctx, fs.end, &fr, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Franchises")
 	}
@@ -82,11 +111,43 @@ func (fs *FranchiseService) Index(opts ...Option) ([]*Franchise, error) {
 	return fr, nil
 }
 
+// Search returns a list of Franchises found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Franchises are found using the provided query, an error is returned.
+func (fs *FranchiseService) Search(qry string, opts ...Option) ([]*Franchise, error) {
+	return fs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Franchises found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Franchises are found using the
+// provided query, an error is returned.
+func (fs *FranchiseService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Franchise, error) {
+	var fr []*Franchise
+
+	opts = append(opts, setSearch(qry))
+	err := fs.client.postWithContext(ctx, fs.end, &fr, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Franchise with query %s", qry)
+	}
+
+	return fr, nil
+}
+
 // Count returns the number of Franchises available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Franchises to count.
 func (fs *FranchiseService) Count(opts ...Option) (int, error) {
-	ct, err := fs.client.getCount(fs.end, opts...)
+	return fs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Franchises available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Franchises to count.
+func (fs *FranchiseService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := fs.client.getCountWithContext(ctx, fs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Franchises")
 	}
@@ -97,7 +158,14 @@ func (fs *FranchiseService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Franchise object.
 func (fs *FranchiseService) Fields() ([]string, error) {
-	f, err := fs.client.getFields(fs.end)
+	return fs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Franchise
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (fs *FranchiseService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := fs.client.getFieldsWithContext(ctx, fs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Franchise fields")
 	}
@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testFranchiseGet  string = "test_data/franchise_get.json"
-	testFranchiseList string = "test_data/franchise_list.json"
+	testFranchiseGet    string = "test_data/franchise_get.json"
+	testFranchiseList   string = "test_data/franchise_list.json"
+	testFranchiseSearch string = "test_data/franchise_search.json"
 )
 
 func TestFranchiseService_Get(t *testing.T) {
@@ -49,7 +50,7 @@ func TestFranchiseService_Get(t *testing.T) {
 			defer ts.Close()
 
 			fr, err := c.Franchises.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,53 @@ func TestFranchiseService_List(t *testing.T) {
 			defer ts.Close()
 
 			fr, err := c.Franchises.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fr, test.wantFranchises) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fr, test.wantFranchises)
+			}
+		})
+	}
+}
+
+func TestFranchiseService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testFranchiseSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Franchise, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		qry            string
+		opts           []Option
+		wantFranchises []*Franchise
+		wantErr        error
+	}{
+		{"Valid response", testFranchiseSearch, "star", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "star", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "star", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fr, err := c.Franchises.Search(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +187,7 @@ func TestFranchiseService_Index(t *testing.T) {
 			defer ts.Close()
 
 			fr, err := c.Franchises.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +218,7 @@ func TestFranchiseService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Franchises.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +248,7 @@ func TestFranchiseService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Franchises.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,9 +1,13 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
+	"reflect"
 	"strconv"
+	"sync"
+	"time"
 )
 
 //go:generate gomodifytags -file $GOFILE -struct Game -add-tags json -w
@@ -20,12 +24,13 @@ type Game struct {
 	Bundles               []int        `json:"bundles"`
 	Category              GameCategory `json:"category"`
 	Collection            int          `json:"collection"`
+	Collections           []int        `json:"collections"`
 	Cover                 int          `json:"cover"`
-	CreatedAt             int          `json:"created_at"`
+	CreatedAt             Timestamp    `json:"created_at"`
 	DLCS                  []int        `json:"dlcs"`
 	Expansions            []int        `json:"expansions"`
 	ExternalGames         []int        `json:"external_games"`
-	FirstReleaseDate      int          `json:"first_release_date"`
+	FirstReleaseDate      Timestamp    `json:"first_release_date"`
 	Follows               int          `json:"follows"`
 	Franchise             int          `json:"franchise"`
 	Franchises            []int        `json:"franchises"`
@@ -54,7 +59,7 @@ type Game struct {
 	Themes                []int        `json:"themes"`
 	TotalRating           float64      `json:"total_rating"`
 	TotalRatingCount      int          `json:"total_rating_count"`
-	UpdatedAt             int          `json:"updated_at"`
+	UpdatedAt             Timestamp    `json:"updated_at"`
 	URL                   string       `json:"url"`
 	VersionParent         int          `json:"version_parent"`
 	VersionTitle          string       `json:"version_title"`
@@ -62,37 +67,129 @@ type Game struct {
 	Websites              []int        `json:"websites"`
 }
 
-// GameCategory specifies a type of game content.
+// GameCategory specifies a type of game content. Its String method returns
+// the label used by the IGDB documentation, such as "main_game". Both the
+// label and the GameCategory itself are filtered by their numeric value.
 type GameCategory int
 
-//go:generate stringer -type=GameCategory,GameStatus
+//go:generate stringer -type=GameCategory,GameStatus -linecomment
 
 // Expected GameCategory enums from the IGDB.
 const (
-	MainGame GameCategory = iota
-	DLCAddon
-	Expansion
-	Bundle
-	StandaloneExpansion
-	Mod
-	Episode
-	Season
+	MainGame            GameCategory = iota // main_game
+	DLCAddon                                // dlc_addon
+	Expansion                               // expansion
+	Bundle                                  // bundle
+	StandaloneExpansion                     // standalone_expansion
+	Mod                                     // mod
+	Episode                                 // episode
+	Season                                  // season
+	Remake                                  // remake
+	Remaster                                // remaster
+	ExpandedGame                            // expanded_game
+	Port                                    // port
+	Fork                                    // fork
+	Pack                                    // pack
+	Update                                  // update
 )
 
-// GameStatus specifies the release status of a specific game.
+// GameStatus specifies the release status of a specific game. Its String
+// method returns the label used by the IGDB documentation, such as
+// "early_access". Both the label and the GameStatus itself are filtered by
+// their numeric value.
 type GameStatus int
 
 // Expected GameStatus enums from the IGDB.
 const (
-	StatusReleased GameStatus = iota
+	StatusReleased GameStatus = iota // released
 	_
-	StatusAlpha
-	StatusBeta
-	StatusEarlyAccess
-	StatusOffline
-	StatusCancelled
+	StatusAlpha       // alpha
+	StatusBeta        // beta
+	StatusEarlyAccess // early_access
+	StatusOffline     // offline
+	StatusCancelled   // cancelled
+	StatusRumored     // rumored
+	StatusDelisted    // delisted
 )
 
+// filterValue returns the number the IGDB filters the GameCategory by.
+func (i GameCategory) filterValue() string {
+	return strconv.Itoa(int(i))
+}
+
+// filterValue returns the number the IGDB filters the GameStatus by.
+func (i GameStatus) filterValue() string {
+	return strconv.Itoa(int(i))
+}
+
+// filterLabels maps the labels of the GameCategory and GameStatus constants
+// to the numbers the IGDB filters them by.
+var filterLabels = labelsOf(
+	MainGame, DLCAddon, Expansion, Bundle, StandaloneExpansion, Mod, Episode, Season,
+	Remake, Remaster, ExpandedGame, Port, Fork, Pack, Update,
+	StatusReleased, StatusAlpha, StatusBeta, StatusEarlyAccess, StatusOffline,
+	StatusCancelled, StatusRumored, StatusDelisted,
+)
+
+// IsMainGame reports whether the Game is a main game.
+func (g *Game) IsMainGame() bool {
+	return g.Category == MainGame
+}
+
+// IsDLC reports whether the Game is a DLC or addon.
+func (g *Game) IsDLC() bool {
+	return g.Category == DLCAddon
+}
+
+// IsExpansion reports whether the Game is an expansion.
+func (g *Game) IsExpansion() bool {
+	return g.Category == Expansion
+}
+
+// IsBundle reports whether the Game is a bundle.
+func (g *Game) IsBundle() bool {
+	return g.Category == Bundle
+}
+
+// IsStandaloneExpansion reports whether the Game is a standalone expansion.
+func (g *Game) IsStandaloneExpansion() bool {
+	return g.Category == StandaloneExpansion
+}
+
+// IsMod reports whether the Game is a mod.
+func (g *Game) IsMod() bool {
+	return g.Category == Mod
+}
+
+// IsEpisode reports whether the Game is an episode.
+func (g *Game) IsEpisode() bool {
+	return g.Category == Episode
+}
+
+// IsReleased reports whether the Game has been released. The IGDB omits the
+// status of released Games, which makes their status indistinguishable from
+// an unknown status. A Game is therefore only considered released if its
+// status is released and its first release date has passed.
+func (g *Game) IsReleased() bool {
+	return g.Status == StatusReleased && !g.FirstReleaseDate.IsZero() && !g.FirstReleaseDate.After(time.Now())
+}
+
+// IsUpcoming reports whether the Game has a first release date that has not
+// yet passed and has not been cancelled.
+func (g *Game) IsUpcoming() bool {
+	return g.Status != StatusCancelled && g.FirstReleaseDate.After(time.Now())
+}
+
+// IsInEarlyAccess reports whether the Game is in early access.
+func (g *Game) IsInEarlyAccess() bool {
+	return g.Status == StatusEarlyAccess
+}
+
+// IsCancelled reports whether the Game has been cancelled.
+func (g *Game) IsCancelled() bool {
+	return g.Status == StatusCancelled
+}
+
 // GameService handles all the API
 // calls for the IGDB Game endpoint.
 type GameService service
@@ -101,14 +198,22 @@ type GameService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Games, an error is returned.
 func (gs *GameService) Get(id int, opts ...Option) (*Game, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Game identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Games, an error is returned.
+func (gs *GameService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Game, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var g []*Game
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &g, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &g, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Game with ID %v", id)
 	}
@@ -117,10 +222,24 @@ func (gs *GameService) Get(id int, opts ...Option) (*Game, error) {
 }
 
 // List returns a list of Games identified by the provided list of IGDB IDs.
-// Provide functional options to sort, filter, and paginate the results.
-// Any ID that does not match a Game is ignored. If none of the IDs
-// match a Game, an error is returned.
+// Provide functional options to filter the results and select their fields.
+// Large lists of IDs are split into chunks retrieved with separate requests,
+// as configured by SetListChunking, and the Games are returned in the order of
+// the provided IDs. Duplicate IDs are ignored, as is any ID that does not
+// match a Game. If none of the IDs match a Game, an error is returned.
 func (gs *GameService) List(ids []int, opts ...Option) ([]*Game, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Games identified by the provided list of
+// IGDB IDs. The requests are canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to filter the results
+// and select their fields. Large lists of IDs are split into chunks retrieved
+// with separate requests, as configured by SetListChunking, and the Games are
+// returned in the order of the provided IDs. Duplicate IDs are ignored, as is
+// any ID that does not match a Game. If none of the IDs match a Game, an error
+// is returned.
+func (gs *GameService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Game, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -131,14 +250,45 @@ func (gs *GameService) List(ids []int, opts ...Option) ([]*Game, error) {
 		}
 	}
 
-	var g []*Game
+	var mu sync.Mutex
+	found := make(map[int]*Game, len(ids))
 
-	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &g, opts...)
+	err := gs.client.forEachChunk(ctx, ids, func(ctx context.Context, chunk []int) error {
+		var g []*Game
+
+		chunkOpts := withDefaults(opts, SetLimit(len(chunk)))
+		chunkOpts = append(chunkOpts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(chunk)...))
+		if err := gs.client.postWithContext(ctx, gs.end, &g, chunkOpts...); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, game := range g {
+			found[game.ID] = game
+		}
+
+		return nil
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Games with IDs %v", ids)
 	}
 
+	var g []*Game
+	for _, id := range ids {
+		if game, ok := found[id]; ok {
+			g = append(g, game)
+			delete(found, id)
+		}
+	}
+
+	if len(g) == 0 {
+		if allowsEmpty(opts) {
+			return []*Game{}, nil
+		}
+		return nil, errors.Wrapf(ErrNoResults, "cannot get Games with IDs %v", ids)
+	}
+
 	return g, nil
 }
 
@@ -146,9 +296,18 @@ func (gs *GameService) List(ids []int, opts ...Option) ([]*Game, error) {
 // options used to sort, filter, and paginate the results. If no Games can
 // be found using the provided options, an error is returned.
 func (gs *GameService) Index(opts ...Option) ([]*Game, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Games based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Games can be found using the provided options, an error is
+// returned.
+func (gs *GameService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Game, error) {
 	var g []*Game
 
-	err := gs.client.post(gs.end, &g, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &g, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Games")
 	}
@@ -156,14 +315,60 @@ func (gs *GameService) Index(opts ...Option) ([]*Game, error) {
 	return g, nil
 }
 
+// IndexWithResponse returns an index of Games based solely on the provided
+// functional options used to sort, filter, and paginate the results, along with
+// the metadata of the response such as the number of matching Games. If no Games
+// can be found using the provided options, an error is returned. The Response is
+// returned alongside any error caused by the IGDB responding with an error status.
+func (gs *GameService) IndexWithResponse(opts ...Option) ([]*Game, *Response, error) {
+	var g []*Game
+
+	resp, err := gs.client.postWithResponse(context.Background(), gs.end, &g, opts...)
+	if err != nil {
+		return nil, resp, errors.Wrap(err, "cannot get index of Games")
+	}
+
+	return g, resp, nil
+}
+
+// Query returns a list of Games found by sending the provided apicalypse query
+// string verbatim to the IGDB, bypassing the functional options. If the query
+// is empty, an error is returned without contacting the IGDB. If no Games are
+// found using the provided query, an error is returned.
+func (gs *GameService) Query(qry string) ([]*Game, error) {
+	var g []*Game
+
+	err := gs.client.GetRaw(gs.end, qry, &g)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot query Games")
+	}
+
+	return g, nil
+}
+
 // Search returns a list of Games found by searching the IGDB using the provided
-// query. Provide functional options to sort, filter, and paginate the results. If
-// no Games are found using the provided query, an error is returned.
+// query. Double quotes and backslashes in the query are escaped. Provide
+// functional options to select fields, filter, and paginate the results. The
+// results are ordered by relevance, so the SetOrder functional option cannot
+// be used. If the query is blank, an error is returned without contacting the
+// IGDB. If no Games are found using the provided query, an error is returned.
 func (gs *GameService) Search(qry string, opts ...Option) ([]*Game, error) {
+	return gs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Games found by searching the IGDB using
+// the provided query. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Double quotes and backslashes in the query are
+// escaped. Provide functional options to select fields, filter, and paginate the
+// results. The results are ordered by relevance, so the SetOrder functional
+// option cannot be used. If the query is blank, an error is returned without
+// contacting the IGDB. If no Games are found using the provided query, an error
+// is returned.
+func (gs *GameService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Game, error) {
 	var g []*Game
 
 	opts = append(opts, setSearch(qry))
-	err := gs.client.post(gs.end, &g, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &g, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Game with query %s", qry)
 	}
@@ -171,11 +376,290 @@ func (gs *GameService) Search(qry string, opts ...Option) ([]*Game, error) {
 	return g, nil
 }
 
+// SearchFirst returns the Game most relevant to the provided query. Provide
+// functional options to select fields and filter the results. Any limit is
+// replaced with a limit of one. If no Games are found using the provided
+// query, an error is returned.
+func (gs *GameService) SearchFirst(qry string, opts ...Option) (*Game, error) {
+	opts = append(withoutClauses(opts, "limit", clauseAllowEmpty), SetLimit(1))
+
+	g, err := gs.Search(qry, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return g[0], nil
+}
+
+// GetByEngine returns a list of Games built on the GameEngine identified by
+// the provided IGDB ID. Provide functional options to sort, filter, and
+// paginate the results. If no Games are found, an error is returned.
+func (gs *GameService) GetByEngine(engineID int, opts ...Option) ([]*Game, error) {
+	if engineID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var g []*Game
+
+	opts = append(opts, SetFilter("game_engines", OpContainsAll, strconv.Itoa(engineID)))
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with GameEngine ID %v", engineID)
+	}
+
+	return g, nil
+}
+
+// ByEngine returns a list of Games built on the GameEngine identified by the
+// provided IGDB ID, matching any Game whose game_engines include it. Provide
+// functional options to sort, filter, and paginate the results. If no Games
+// are found, an error is returned.
+func (gs *GameService) ByEngine(engineID int, opts ...Option) ([]*Game, error) {
+	if engineID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var g []*Game
+
+	opts = append(opts, SetFilter("game_engines", OpContainsAtLeast, strconv.Itoa(engineID)))
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with GameEngine ID %v", engineID)
+	}
+
+	return g, nil
+}
+
+// GetByEngines returns a list of Games built on the GameEngines identified by
+// the provided list of IGDB IDs. If matchAll is true, only Games built on every
+// one of the GameEngines are returned. Otherwise, Games built on any of the
+// GameEngines are returned. Provide functional options to sort, filter, and
+// paginate the results. If no Games are found, an error is returned.
+func (gs *GameService) GetByEngines(engineIDs []int, matchAll bool, opts ...Option) ([]*Game, error) {
+	if len(engineIDs) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range engineIDs {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	op := OpContainsAtLeast
+	if matchAll {
+		op = OpContainsAll
+	}
+
+	var g []*Game
+
+	opts = append(opts, SetFilter("game_engines", op, sliceconv.Itoa(engineIDs)...))
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with GameEngine IDs %v", engineIDs)
+	}
+
+	return g, nil
+}
+
+// GetByEngineName returns a list of Games built on the GameEngine with the
+// provided name (e.g. "Unreal Engine 4"). The name is first resolved to a
+// GameEngine using its slug, then the Games are retrieved in a second request.
+// Provide functional options to sort, filter, and paginate the Games. If the
+// name does not match a GameEngine or no Games are found, an error is returned.
+func (gs *GameService) GetByEngineName(name string, opts ...Option) ([]*Game, error) {
+	eng, err := gs.client.GameEngines.GetBySlug(slugify(name), SetFields("id"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with GameEngine name %s", name)
+	}
+
+	return gs.GetByEngine(eng.ID, opts...)
+}
+
+// GetByMultiplePlatforms returns a list of Games available on the Platforms
+// identified by the provided list of IGDB IDs. If requireAll is true, only
+// Games available on every one of the Platforms are returned (e.g. Games on
+// both PlayStation 5 and PC). Otherwise, Games available on any of the
+// Platforms are returned (e.g. Games on either Xbox or PC). Every ID must be
+// positive. Provide functional options to sort, filter, and paginate the
+// results. If no Games are found, an error is returned.
+func (gs *GameService) GetByMultiplePlatforms(platformIDs []int, requireAll bool, opts ...Option) ([]*Game, error) {
+	if len(platformIDs) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range platformIDs {
+		if id <= 0 {
+			return nil, ErrNonPositiveID
+		}
+	}
+
+	op := OpContainsAtLeast
+	if requireAll {
+		op = OpContainsAll
+	}
+
+	var g []*Game
+
+	opts = append(opts, SetFilter("platforms", op, sliceconv.Itoa(platformIDs)...))
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with Platform IDs %v", platformIDs)
+	}
+
+	return g, nil
+}
+
+// ByGameMode returns the list of Games that support the GameMode
+// identified by the provided IGDB ID, such as co-operative or battle royale.
+// Provide functional options to sort, filter, and paginate the results. If no
+// Games are found, an error is returned.
+func (gs *GameService) ByGameMode(modeID int, opts ...Option) ([]*Game, error) {
+	if modeID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var g []*Game
+
+	opts = append(opts, Field("game_modes").Contains(modeID).Option())
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with GameMode ID %v", modeID)
+	}
+
+	return g, nil
+}
+
+// GetByCollection returns the list of Games belonging to the Collection, or
+// series, identified by the provided IGDB ID. Both the singular collection
+// field and the plural collections field are matched. The Games are sorted by
+// their first release date in ascending order unless another order is provided.
+// Provide functional options to sort, filter, and paginate the results. If the
+// Collection has no Games, an error is returned.
+func (gs *GameService) GetByCollection(collectionID int, opts ...Option) ([]*Game, error) {
+	if collectionID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var g []*Game
+
+	opts = withDefaults(opts, SetOrder("first_release_date", OrderAscending))
+	opts = append(opts, Field("collection").Eq(collectionID).Or(Field("collections").Contains(collectionID)).Option())
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with Collection ID %v", collectionID)
+	}
+
+	return g, nil
+}
+
+// GetCriticallyAcclaimed returns a list of Games with an aggregated rating of
+// at least minRating from at least minCount critic reviews. The Games are
+// sorted by their aggregated rating in descending order unless another order
+// is provided.
+//
+// The aggregated rating is based solely on external critic scores, similar to
+// a Metacritic score, while the total rating blends the critic scores with the
+// scores given by IGDB users. For Games rated by users, see GetUserRated.
+//
+// The rating must be between 0 and 100 and the count cannot be negative.
+// Provide functional options to sort, filter, and paginate the results. If no
+// Games are found, an error is returned.
+func (gs *GameService) GetCriticallyAcclaimed(minRating float64, minCount int, opts ...Option) ([]*Game, error) {
+	g, err := gs.getRated("aggregated_rating", minRating, minCount, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with aggregated rating of %v from %v reviews", minRating, minCount)
+	}
+
+	return g, nil
+}
+
+// GetUserRated returns a list of Games with a rating of at least minRating
+// from at least minCount IGDB users. The Games are sorted by their rating in
+// descending order unless another order is provided.
+//
+// The rating is based solely on the scores given by IGDB users, unlike the
+// aggregated rating which is based on external critic scores. For Games rated
+// by critics, see GetCriticallyAcclaimed.
+//
+// The rating must be between 0 and 100 and the count cannot be negative.
+// Provide functional options to sort, filter, and paginate the results. If no
+// Games are found, an error is returned.
+func (gs *GameService) GetUserRated(minRating float64, minCount int, opts ...Option) ([]*Game, error) {
+	g, err := gs.getRated("rating", minRating, minCount, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with user rating of %v from %v users", minRating, minCount)
+	}
+
+	return g, nil
+}
+
+// getRated returns a list of Games with a value of at least minRating for the
+// provided rating field and a value of at least minCount for its matching
+// count field, sorted by the rating field in descending order by default.
+func (gs *GameService) getRated(field string, minRating float64, minCount int, opts ...Option) ([]*Game, error) {
+	if minRating < 0 || minRating > 100 || minCount < 0 {
+		return nil, ErrOutOfRange
+	}
+
+	var g []*Game
+
+	opts = withDefaults(opts, SetOrder(field, OrderDescending))
+	opts = append(opts,
+		SetFilter(field, OpGreaterThanEqual, strconv.FormatFloat(minRating, 'f', -1, 64)),
+		SetFilter(field+"_count", OpGreaterThanEqual, strconv.Itoa(minCount)),
+	)
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// GetLocalizations returns the list of GameLocalizations belonging to the
+// Game identified by the provided IGDB ID. Provide functional options to
+// sort, filter, and paginate the results. If the Game has no
+// GameLocalizations, an error is returned.
+func (gs *GameService) GetLocalizations(gameID int, opts ...Option) ([]*GameLocalization, error) {
+	return gs.client.GameLocalizations.GetByGame(gameID, opts...)
+}
+
+// GetLocalization returns the GameLocalization of the Game identified by the
+// provided IGDB ID for the region identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the Game has no GameLocalization for the region, ErrNoResults
+// is returned.
+func (gs *GameService) GetLocalization(gameID int, regionID int, opts ...Option) (*GameLocalization, error) {
+	if regionID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	opts = append(requireResults(opts), SetFilter("region", OpEquals, strconv.Itoa(regionID)))
+	loc, err := gs.GetLocalizations(gameID, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalization for region ID %v", regionID)
+	}
+
+	return loc[0], nil
+}
+
 // Count returns the number of Games available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
-// which Games to count.
+// which Games to count. The SetFields, SetLimit, SetOffset, and
+// SetOrder functional options have no effect on a count and are
+// rejected with an error.
 func (gs *GameService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Games available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Games to count. The SetFields, SetLimit, SetOffset, and SetOrder functional
+// options have no effect on a count and are rejected with an error.
+func (gs *GameService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Games")
 	}
@@ -183,13 +667,48 @@ func (gs *GameService) Count(opts ...Option) (int, error) {
 	return ct, nil
 }
 
+// GetMetadata returns the metadata the IGDB reports for a query on Games
+// without retrieving any of the Games themselves. This is useful for checking
+// how many Games match a filter before paginating through them. Provide the
+// SetFilter functional option if you need to filter which Games to match.
+func (gs *GameService) GetMetadata(opts ...Option) (*QueryMetadata, error) {
+	return gs.GetMetadataWithContext(context.Background(), opts...)
+}
+
+// GetMetadataWithContext returns the metadata the IGDB reports for a query on
+// Games without retrieving any of the Games themselves. The request is canceled
+// if the provided context is canceled or its deadline is exceeded. Provide the
+// SetFilter functional option if you need to filter which Games to match.
+func (gs *GameService) GetMetadataWithContext(ctx context.Context, opts ...Option) (*QueryMetadata, error) {
+	md, err := gs.client.getMetadata(ctx, gs.end, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Game metadata")
+	}
+
+	return md, nil
+}
+
 // Fields returns the up-to-date list of fields in an
 // IGDB Game object.
 func (gs *GameService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Game
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (gs *GameService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Game fields")
 	}
 
 	return f, nil
 }
+
+// StructFields returns the JSON field names of the Game struct. Unlike
+// Fields, StructFields does not make an API call. This is useful for building
+// SetFields functional options without mistyping a field name.
+func (gs *GameService) StructFields() []string {
+	return cachedStructFields(reflect.TypeOf(Game{}))
+}
@@ -1,13 +1,17 @@
 package igdb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -16,6 +20,146 @@ const (
 	testGameSearch string = "test_data/game_search.json"
 )
 
+func TestGame_Category(t *testing.T) {
+	var tests = []struct {
+		name string
+		cat  GameCategory
+		want []bool
+	}{
+		{"Main game", MainGame, []bool{true, false, false, false, false, false, false}},
+		{"DLC", DLCAddon, []bool{false, true, false, false, false, false, false}},
+		{"Expansion", Expansion, []bool{false, false, true, false, false, false, false}},
+		{"Bundle", Bundle, []bool{false, false, false, true, false, false, false}},
+		{"Standalone expansion", StandaloneExpansion, []bool{false, false, false, false, true, false, false}},
+		{"Mod", Mod, []bool{false, false, false, false, false, true, false}},
+		{"Episode", Episode, []bool{false, false, false, false, false, false, true}},
+		{"Season", Season, []bool{false, false, false, false, false, false, false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := &Game{Category: test.cat}
+
+			got := []bool{g.IsMainGame(), g.IsDLC(), g.IsExpansion(), g.IsBundle(), g.IsStandaloneExpansion(), g.IsMod(), g.IsEpisode()}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGameCategory_String(t *testing.T) {
+	var tests = []struct {
+		name    string
+		str     fmt.Stringer
+		wantStr string
+	}{
+		{"Main game", MainGame, "main_game"},
+		{"DLC", DLCAddon, "dlc_addon"},
+		{"Standalone expansion", StandaloneExpansion, "standalone_expansion"},
+		{"Expanded game", ExpandedGame, "expanded_game"},
+		{"Update", Update, "update"},
+		{"Unknown category", GameCategory(99), "GameCategory(99)"},
+		{"Released", StatusReleased, "released"},
+		{"Early access", StatusEarlyAccess, "early_access"},
+		{"Delisted", StatusDelisted, "delisted"},
+		{"Status gap", GameStatus(1), "GameStatus(1)"},
+		{"Unknown status", GameStatus(99), "GameStatus(99)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.str.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.str.String(), test.wantStr)
+			}
+		})
+	}
+}
+
+func TestGameCategory_RoundTrip(t *testing.T) {
+	var tests = []struct {
+		name       string
+		data       string
+		wantCat    GameCategory
+		wantStatus GameStatus
+	}{
+		{"Known values", `{"category":8,"status":4}`, Remake, StatusEarlyAccess},
+		{"Unknown values", `{"category":99,"status":42}`, GameCategory(99), GameStatus(42)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var g Game
+			err := json.Unmarshal([]byte(test.data), &g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if g.Category != test.wantCat || g.Status != test.wantStatus {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", g.Category, g.Status, test.wantCat, test.wantStatus)
+			}
+
+			b, err := json.Marshal(&g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range strings.Split(strings.Trim(test.data, "{}"), ",") {
+				if !strings.Contains(string(b), want) {
+					t.Errorf("got: <%v>, want: <%v>", string(b), want)
+				}
+			}
+
+			cond := Field("category").Eq(g.Category)
+			wantCond := "category = " + strconv.Itoa(int(test.wantCat))
+			if cond.String() != wantCond {
+				t.Errorf("got: <%v>, want: <%v>", cond.String(), wantCond)
+			}
+		})
+	}
+}
+
+func TestGame_Status(t *testing.T) {
+	past := Timestamp{time.Now().AddDate(-1, 0, 0)}
+	future := Timestamp{time.Now().AddDate(1, 0, 0)}
+
+	var tests = []struct {
+		name          string
+		game          *Game
+		wantReleased  bool
+		wantUpcoming  bool
+		wantEarly     bool
+		wantCancelled bool
+	}{
+		{"Released", &Game{Status: StatusReleased, FirstReleaseDate: past}, true, false, false, false},
+		{"Unknown status and date", &Game{}, false, false, false, false},
+		{"Future release", &Game{FirstReleaseDate: future}, false, true, false, false},
+		{"Early access", &Game{Status: StatusEarlyAccess, FirstReleaseDate: past}, false, false, true, false},
+		{"Early access with future release", &Game{Status: StatusEarlyAccess, FirstReleaseDate: future}, false, true, true, false},
+		{"Cancelled", &Game{Status: StatusCancelled, FirstReleaseDate: future}, false, false, false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.game.IsReleased() != test.wantReleased {
+				t.Errorf("IsReleased got: <%v>, want: <%v>", test.game.IsReleased(), test.wantReleased)
+			}
+
+			if test.game.IsUpcoming() != test.wantUpcoming {
+				t.Errorf("IsUpcoming got: <%v>, want: <%v>", test.game.IsUpcoming(), test.wantUpcoming)
+			}
+
+			if test.game.IsInEarlyAccess() != test.wantEarly {
+				t.Errorf("IsInEarlyAccess got: <%v>, want: <%v>", test.game.IsInEarlyAccess(), test.wantEarly)
+			}
+
+			if test.game.IsCancelled() != test.wantCancelled {
+				t.Errorf("IsCancelled got: <%v>, want: <%v>", test.game.IsCancelled(), test.wantCancelled)
+			}
+		})
+	}
+}
+
 func TestGameService_Get(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameGet)
 	if err != nil {
@@ -51,7 +195,7 @@ func TestGameService_Get(t *testing.T) {
 			defer ts.Close()
 
 			g, err := c.Games.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -98,7 +242,7 @@ func TestGameService_List(t *testing.T) {
 			defer ts.Close()
 
 			g, err := c.Games.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -142,7 +286,114 @@ func TestGameService_Index(t *testing.T) {
 			defer ts.Close()
 
 			g, err := c.Games.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+		})
+	}
+}
+
+func TestGameService_IndexWithResponse(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		status    int
+		file      string
+		headers   []testHeader
+		wantGames []*Game
+		wantCount int
+		wantLimit int
+		wantErr   error
+	}{
+		{"Valid response with headers", http.StatusOK, testGameList, []testHeader{{headerCount, "1234"}, {headerRateLimit, "4"}, {headerRateRemaining, "3"}}, init, 1234, 4, nil},
+		{"Valid response without headers", http.StatusOK, testGameList, nil, init, -1, -1, nil},
+		{"No results", http.StatusOK, testFileEmptyArray, []testHeader{{headerCount, "0"}}, nil, 0, -1, ErrNoResults},
+		{"Bad status", http.StatusBadRequest, testFileEmpty, nil, nil, -1, -1, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(test.status, test.file, test.headers...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, resp, err := c.Games.IndexWithResponse()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if resp == nil {
+				t.Fatal("got: <nil> Response, want: non-nil Response")
+			}
+
+			if resp.Status != test.status {
+				t.Errorf("got: <%v>, want: <%v>", resp.Status, test.status)
+			}
+
+			if resp.Count != test.wantCount {
+				t.Errorf("got: <%v>, want: <%v>", resp.Count, test.wantCount)
+			}
+
+			if resp.RateLimit != test.wantLimit {
+				t.Errorf("got: <%v>, want: <%v>", resp.RateLimit, test.wantLimit)
+			}
+		})
+	}
+}
+
+func TestGameService_Query(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		file      string
+		qry       string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, "fields *; where rating > 80; limit 5;", init, nil},
+		{"Empty query", testGameList, "", nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "fields *;", nil, errInvalidJSON},
+		{"No results", testFileEmptyArray, "fields *;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, err := c.Games.Query(test.qry)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -178,6 +429,9 @@ func TestGameService_Search(t *testing.T) {
 		{"Empty response", testFileEmpty, "mario", nil, nil, errInvalidJSON},
 		{"Invalid option", testFileEmpty, "mario", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
 		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+		{"Blank query", testFileEmpty, "   ", nil, nil, ErrEmptyQry},
+		{"Search with order", testGameSearch, "mario", []Option{SetOrder("rating", OrderDescending)}, nil, ErrOptionConflict},
+		{"Search with fields and filter", testGameSearch, "mario", []Option{SetFields("name"), SetFilter("rating", OpGreaterThan, "80")}, init, nil},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -188,7 +442,7 @@ func TestGameService_Search(t *testing.T) {
 			defer ts.Close()
 
 			g, err := c.Games.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -199,68 +453,836 @@ func TestGameService_Search(t *testing.T) {
 	}
 }
 
-func TestGameService_Count(t *testing.T) {
+func TestGameService_WithContext(t *testing.T) {
+	ts, c, err := testServerFile(http.StatusOK, testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
 	var tests = []struct {
-		name      string
-		resp      string
-		opts      []Option
-		wantCount int
-		wantErr   error
+		name string
+		call func() error
 	}{
-		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
-		{"Empty response", "", nil, 0, errInvalidJSON},
-		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
-		{"No results", "[]", nil, 0, ErrNoResults},
+		{"Get", func() error { _, err := c.Games.GetWithContext(ctx, 1942); return err }},
+		{"List", func() error { _, err := c.Games.ListWithContext(ctx, []int{1942}); return err }},
+		{"Index", func() error { _, err := c.Games.IndexWithContext(ctx); return err }},
+		{"Search", func() error { _, err := c.Games.SearchWithContext(ctx, "witcher"); return err }},
+		{"Count", func() error { _, err := c.Games.CountWithContext(ctx); return err }},
+		{"Fields", func() error { _, err := c.Games.FieldsWithContext(ctx); return err }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if errors.Cause(err) != context.Canceled {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), context.Canceled)
+			}
+		})
 	}
+}
 
+func TestGameService_Search_Escaping(t *testing.T) {
+	tests := []struct {
+		name    string
+		qry     string
+		wantQry string
+	}{
+		{"Plain query", "mario", `search "mario"; `},
+		{"Apostrophe", "Tom Clancy's", `search "Tom Clancy's"; `},
+		{"Double quotes", `Tom Clancy's "The Division"`, `search "Tom Clancy's \"The Division\""; `},
+		{"Backslash", `AC\DC`, `search "AC\\DC"; `},
+	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			ts, c := testServerString(http.StatusOK, test.resp)
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				body = string(b)
+				w.Write([]byte("[]"))
+			})
 			defer ts.Close()
 
-			count, err := c.Games.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			c.Games.Search(test.qry)
+
+			if body != test.wantQry {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantQry)
+			}
+		})
+	}
+}
+
+func TestGameService_SearchFirst(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		qry      string
+		opts     []Option
+		wantGame *Game
+		wantErr  error
+	}{
+		{"Valid response", testGameSearch, "mario", nil, init[0], nil},
+		{"Limit replaced", testGameSearch, "mario", []Option{SetLimit(5)}, init[0], nil},
+		{"Empty query", testFileEmpty, "", nil, nil, ErrEmptyQry},
+		{"Search with order", testGameSearch, "mario", []Option{SetOrder("rating", OrderDescending)}, nil, ErrOptionConflict},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				if !strings.Contains(string(b), "limit 1;") {
+					t.Errorf("got: <%s>, want query with limit of 1", b)
+				}
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Error(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.SearchFirst(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
-			if count != test.wantCount {
-				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			if !reflect.DeepEqual(g, test.wantGame) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGame)
 			}
 		})
 	}
 }
 
-func TestGameService_Fields(t *testing.T) {
+func TestGameService_GetByEngine(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	var tests = []struct {
-		name       string
-		resp       string
-		wantFields []string
-		wantErr    error
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantGames []*Game
+		wantErr   error
 	}{
-		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
-		{"Dot operator", `["logo.url", "background.id"]`, []string{"background.id", "logo.url"}, nil},
-		{"Asterisk", `["*"]`, []string{"*"}, nil},
-		{"Empty response", "", nil, errInvalidJSON},
-		{"No results", "[]", nil, nil},
+		{"Valid response", testGameList, 6, []Option{SetLimit(5)}, init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 6, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 6, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 9999999, nil, nil, ErrNoResults},
 	}
-
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			ts, c := testServerString(http.StatusOK, test.resp)
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
 			defer ts.Close()
 
-			fields, err := c.Games.Fields()
-			if errors.Cause(err) != test.wantErr {
+			g, err := c.Games.GetByEngine(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
-			if !equalSlice(fields, test.wantFields) {
-				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
 			}
 		})
 	}
 }
 
+func TestGameService_ByEngine(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantQry   string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, 6, []Option{SetLimit(5)}, "where game_engines = (6); limit 5; ", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 6, nil, "where game_engines = (6); ", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 6, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 9999999, nil, "where game_engines = (9999999); ", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.ByEngine(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if body != test.wantQry {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantQry)
+			}
+		})
+	}
+}
+
+func TestGameService_GetByEngines(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		ids       []int
+		matchAll  bool
+		opts      []Option
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response, match any", testGameList, []int{6, 13}, false, []Option{SetLimit(5)}, init, nil},
+		{"Valid response, match all", testGameList, []int{6, 13}, true, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, false, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{6, -1}, false, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{6}, true, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{6}, true, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{9999999}, false, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, err := c.Games.GetByEngines(test.ids, test.matchAll, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+		})
+	}
+}
+
+func TestGameService_GetByMultiplePlatforms(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		ids        []int
+		requireAll bool
+		opts       []Option
+		wantGames  []*Game
+		wantErr    error
+	}{
+		{"Valid response, match any", testGameList, []int{48, 6}, false, []Option{SetLimit(5)}, init, nil},
+		{"Valid response, match all", testGameList, []int{48, 6}, true, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, false, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{48, -1}, false, nil, nil, ErrNonPositiveID},
+		{"Zero ID", testFileEmpty, []int{0, 6}, true, nil, nil, ErrNonPositiveID},
+		{"Empty response", testFileEmpty, []int{48}, true, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{48}, true, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{9999999}, false, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, err := c.Games.GetByMultiplePlatforms(test.ids, test.requireAll, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+		})
+	}
+}
+
+func TestGameService_GetByEngineName(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		engineName string
+		wantGames  []*Game
+		wantErr    error
+	}{
+		{"Valid response", testGameList, "Unreal Engine 4", init, nil},
+		{"Empty name", testFileEmpty, "", nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "Unreal Engine 4", nil, errInvalidJSON},
+		{"No results", testFileEmptyArray, "Non-existent Engine", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := ioutil.ReadFile(test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.GetByEngineName(test.engineName)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+		})
+	}
+}
+
+func TestGameService_ByGameMode(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantWhere string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, 3, []Option{SetLimit(5)}, "where game_modes = [3];", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 3, nil, "where game_modes = [3];", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 3, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 9999999, nil, "where game_modes = [9999999];", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.ByGameMode(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if !strings.Contains(body, test.wantWhere) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantWhere)
+			}
+		})
+	}
+}
+
+func TestGameService_GetByCollection(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantSort  string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, 3, []Option{SetLimit(5)}, "sort first_release_date asc", init, nil},
+		{"Custom order", testGameList, 3, []Option{SetOrder("rating", OrderDescending)}, "sort rating desc", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 3, nil, "sort first_release_date asc", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 3, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 9999999, nil, "sort first_release_date asc", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.GetByCollection(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if test.wantSort == "" {
+				return
+			}
+
+			want := fmt.Sprintf("where (collection = %d | collections = [%d])", test.id, test.id)
+			if !strings.Contains(body, want) || !strings.Contains(body, test.wantSort) {
+				t.Errorf("got: <%v>, want: <%v> and <%v>", body, want, test.wantSort)
+			}
+		})
+	}
+}
+
+func TestGameService_GetCriticallyAcclaimed(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		rating    float64
+		count     int
+		opts      []Option
+		wantQry   []string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, 85.5, 10, []Option{SetLimit(5)}, []string{"aggregated_rating >= 85.5", "aggregated_rating_count >= 10", "sort aggregated_rating desc"}, init, nil},
+		{"Custom order", testGameList, 90, 0, []Option{SetOrder("name", OrderAscending)}, []string{"aggregated_rating >= 90", "aggregated_rating_count >= 0", "sort name asc"}, init, nil},
+		{"Rating too low", testFileEmpty, -1, 10, nil, nil, nil, ErrOutOfRange},
+		{"Rating too high", testFileEmpty, 100.5, 10, nil, nil, nil, ErrOutOfRange},
+		{"Negative count", testFileEmpty, 80, -1, nil, nil, nil, ErrOutOfRange},
+		{"Empty response", testFileEmpty, 80, 10, nil, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 80, 10, []Option{SetOffset(-99999)}, nil, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 100, 10000, nil, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.GetCriticallyAcclaimed(test.rating, test.count, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			for _, want := range test.wantQry {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGameService_GetUserRated(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		rating    float64
+		count     int
+		opts      []Option
+		wantQry   []string
+		wantGames []*Game
+		wantErr   error
+	}{
+		{"Valid response", testGameList, 85.5, 10, []Option{SetLimit(5)}, []string{"rating >= 85.5", "rating_count >= 10", "sort rating desc"}, init, nil},
+		{"Custom order", testGameList, 90, 0, []Option{SetOrder("name", OrderAscending)}, []string{"rating >= 90", "rating_count >= 0", "sort name asc"}, init, nil},
+		{"Rating too low", testFileEmpty, -1, 10, nil, nil, nil, ErrOutOfRange},
+		{"Rating too high", testFileEmpty, 100.5, 10, nil, nil, nil, ErrOutOfRange},
+		{"Negative count", testFileEmpty, 80, -1, nil, nil, nil, ErrOutOfRange},
+		{"Empty response", testFileEmpty, 80, 10, nil, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 80, 10, []Option{SetOffset(-99999)}, nil, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 100, 10000, nil, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			g, err := c.Games.GetUserRated(test.rating, test.count, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			for _, want := range test.wantQry {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGameService_GetLocalizations(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		id       int
+		wantLocs []*GameLocalization
+		wantErr  error
+	}{
+		{"Valid response", testGameLocalizationList, 1942, init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, ErrNegativeID},
+		{"No results", testFileEmptyArray, 1942, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.Games.GetLocalizations(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLocs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLocs)
+			}
+		})
+	}
+}
+
+func TestGameService_GetLocalization(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		gameID   int
+		regionID int
+		wantLoc  *GameLocalization
+		wantErr  error
+	}{
+		{"Valid response", testGameLocalizationGet, 1942, 3, init[0], nil},
+		{"Invalid game ID", testFileEmpty, -1, 3, nil, ErrNegativeID},
+		{"Invalid region ID", testFileEmpty, 1942, -3, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, 3, nil, errInvalidJSON},
+		{"No localization for region", testFileEmptyArray, 1942, 8, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.Games.GetLocalization(test.gameID, test.regionID, SetFields("name", "cover"))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLoc) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLoc)
+			}
+		})
+	}
+}
+
+func TestGameService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.Games.Count(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
+
+func TestGameService_GetMetadata(t *testing.T) {
+	var tests = []struct {
+		name     string
+		status   int
+		headers  []testHeader
+		wantMeta *QueryMetadata
+		wantErr  error
+	}{
+		{"Happy path", http.StatusOK, []testHeader{{headerCount, "100"}}, &QueryMetadata{MatchingCount: 100}, nil},
+		{"Bad status", http.StatusBadRequest, nil, nil, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, "[]", test.headers...)
+			defer ts.Close()
+
+			md, err := c.Games.GetMetadata(SetFilter("hypes", OpGreaterThan, "75"))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(md, test.wantMeta) {
+				t.Errorf("got: <%v>, want: <%v>", md, test.wantMeta)
+			}
+		})
+	}
+}
+
+func TestGameService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Dot operator", `["logo.url", "background.id"]`, []string{"background.id", "logo.url"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.Games.Fields()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestGameService_StructFields(t *testing.T) {
+	c := NewClient(testClientID, testToken, nil)
+
+	fields := c.Games.StructFields()
+	if len(fields) == 0 || fields[0] != "id" {
+		t.Errorf("got: <%v>, want fields starting with: <%v>", fields, "id")
+	}
+
+	if _, err := SetFields(fields...)(); err != nil {
+		t.Errorf("got: <%v>, want usable SetFields option", err)
+	}
+}
+
 func ExampleGameService_Get() {
 	c := NewClient("YOUR_CLIENT_ID", "YOUR_APP_ACCESS_TOKEN", nil)
 
@@ -1,9 +1,13 @@
 package igdb
 
 import (
+	"context"
+	"github.com/Henry-Sarabia/blank"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
+	"strings"
+	"unicode"
 )
 
 //go:generate gomodifytags -file $GOFILE -struct GameEngine -add-tags json -w
@@ -11,16 +15,16 @@ import (
 // GameEngine represents a video game engine such as Unreal Engine.
 // For more information visit: https://api-docs.igdb.com/#game-engine
 type GameEngine struct {
-	ID          int    `json:"id"`
-	Companies   []int  `json:"companies"`
-	CreatedAt   int    `json:"created_at"`
-	Description string `json:"description"`
-	Logo        int    `json:"logo"`
-	Name        string `json:"name"`
-	Platforms   []int  `json:"platforms"`
-	Slug        string `json:"slug"`
-	UpdatedAt   int    `json:"updated_at"`
-	URL         string `json:"url"`
+	ID          int       `json:"id"`
+	Companies   []int     `json:"companies"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Description string    `json:"description"`
+	Logo        int       `json:"logo"`
+	Name        string    `json:"name"`
+	Platforms   []int     `json:"platforms"`
+	Slug        string    `json:"slug"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	URL         string    `json:"url"`
 }
 
 // GameEngineService handles all the API calls for the IGDB GameEngine endpoint.
@@ -30,14 +34,23 @@ type GameEngineService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameEngines, an error is returned.
 func (gs *GameEngineService) Get(id int, opts ...Option) (*GameEngine, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameEngine identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any GameEngines,
+// an error is returned.
+func (gs *GameEngineService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameEngine, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var eng []*GameEngine
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &eng, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &eng, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameEngine with ID %v", id)
 	}
@@ -45,11 +58,40 @@ func (gs *GameEngineService) Get(id int, opts ...Option) (*GameEngine, error) {
 	return eng[0], nil
 }
 
+// GetBySlug returns a single GameEngine identified by the provided IGDB slug
+// (e.g. "unreal-engine-4"). Provide the SetFields functional option if you
+// need to specify which fields to retrieve. If the slug does not match any
+// GameEngines, an error is returned.
+func (gs *GameEngineService) GetBySlug(slug string, opts ...Option) (*GameEngine, error) {
+	if blank.Is(slug) {
+		return nil, ErrEmptyQry
+	}
+
+	var eng []*GameEngine
+
+	opts = append(requireResults(opts), Field("slug").Eq(slug).Option())
+	err := gs.client.post(gs.end, &eng, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameEngine with slug %s", slug)
+	}
+
+	return eng[0], nil
+}
+
 // List returns a list of GameEngines identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a GameEngine is ignored. If none of the IDs
 // match a GameEngine, an error is returned.
 func (gs *GameEngineService) List(ids []int, opts ...Option) ([]*GameEngine, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameEngines identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a GameEngine is ignored. If
+// none of the IDs match a GameEngine, an error is returned.
+func (gs *GameEngineService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameEngine, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -63,7 +105,7 @@ func (gs *GameEngineService) List(ids []int, opts ...Option) ([]*GameEngine, err
 	var eng []*GameEngine
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &eng, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &eng, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameEngines with IDs %v", ids)
 	}
@@ -75,9 +117,18 @@ func (gs *GameEngineService) List(ids []int, opts ...Option) ([]*GameEngine, err
 // options used to sort, filter, and paginate the results. If no GameEngines can
 // be found using the provided options, an error is returned.
 func (gs *GameEngineService) Index(opts ...Option) ([]*GameEngine, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameEngines based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no GameEngines can be found using the provided options, an error
+// is returned.
+func (gs *GameEngineService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameEngine, error) {
 	var eng []*GameEngine
 
-	err := gs.client.post(gs.end, &eng, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &eng, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameEngines")
 	}
@@ -85,11 +136,43 @@ func (gs *GameEngineService) Index(opts ...Option) ([]*GameEngine, error) {
 	return eng, nil
 }
 
+// Search returns a list of GameEngines found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no GameEngines are found using the provided query, an error is returned.
+func (gs *GameEngineService) Search(qry string, opts ...Option) ([]*GameEngine, error) {
+	return gs.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of GameEngines found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no GameEngines are found using the
+// provided query, an error is returned.
+func (gs *GameEngineService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*GameEngine, error) {
+	var eng []*GameEngine
+
+	opts = append(opts, setSearch(qry))
+	err := gs.client.postWithContext(ctx, gs.end, &eng, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameEngine with query %s", qry)
+	}
+
+	return eng, nil
+}
+
 // Count returns the number of GameEngines available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which GameEngines to count.
 func (gs *GameEngineService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameEngines available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// GameEngines to count.
+func (gs *GameEngineService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameEngines")
 	}
@@ -100,10 +183,28 @@ func (gs *GameEngineService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameEngine object.
 func (gs *GameEngineService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB GameEngine
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (gs *GameEngineService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameEngine fields")
 	}
 
 	return f, nil
 }
+
+// slugify returns the provided name in the form of an IGDB slug. Letters and
+// digits are lowercased and every other run of characters becomes a hyphen
+// (e.g. "Unreal Engine 4" becomes "unreal-engine-4").
+func slugify(name string) string {
+	f := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(f, "-")
+}
@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testGameEngineGet  string = "test_data/gameengine_get.json"
-	testGameEngineList string = "test_data/gameengine_list.json"
+	testGameEngineGet    string = "test_data/gameengine_get.json"
+	testGameEngineList   string = "test_data/gameengine_list.json"
+	testGameEngineSearch string = "test_data/gameengine_search.json"
 )
 
 func TestGameEngineService_Get(t *testing.T) {
@@ -49,7 +50,7 @@ func TestGameEngineService_Get(t *testing.T) {
 			defer ts.Close()
 
 			eng, err := c.GameEngines.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -60,6 +61,52 @@ func TestGameEngineService_Get(t *testing.T) {
 	}
 }
 
+func TestGameEngineService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameEngineGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameEngine, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		file    string
+		slug    string
+		opts    []Option
+		wantEng *GameEngine
+		wantErr error
+	}{
+		{"Valid response", testGameEngineGet, "unreal-engine-4", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "  ", nil, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "unreal-engine-4", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "unreal-engine-4", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-engine", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			eng, err := c.GameEngines.GetBySlug(test.slug, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(eng, test.wantEng) {
+				t.Errorf("got: <%v>, \nwant: <%v>", eng, test.wantEng)
+			}
+		})
+	}
+}
+
 func TestGameEngineService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameEngineList)
 	if err != nil {
@@ -96,7 +143,7 @@ func TestGameEngineService_List(t *testing.T) {
 			defer ts.Close()
 
 			eng, err := c.GameEngines.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +187,53 @@ func TestGameEngineService_Index(t *testing.T) {
 			defer ts.Close()
 
 			eng, err := c.GameEngines.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(eng, test.wantGameEngines) {
+				t.Errorf("got: <%v>, \nwant: <%v>", eng, test.wantGameEngines)
+			}
+		})
+	}
+}
+
+func TestGameEngineService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameEngineSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameEngine, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name            string
+		file            string
+		qry             string
+		opts            []Option
+		wantGameEngines []*GameEngine
+		wantErr         error
+	}{
+		{"Valid response", testGameEngineSearch, "unreal", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "unreal", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "unreal", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			eng, err := c.GameEngines.Search(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +264,7 @@ func TestGameEngineService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.GameEngines.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -182,6 +275,28 @@ func TestGameEngineService_Count(t *testing.T) {
 	}
 }
 
+func TestSlugify(t *testing.T) {
+	var tests = []struct {
+		name     string
+		in       string
+		wantSlug string
+	}{
+		{"Single word", "Unity", "unity"},
+		{"Multiple words", "Unreal Engine 4", "unreal-engine-4"},
+		{"Punctuation", "RE Engine (Capcom)", "re-engine-capcom"},
+		{"Surrounding whitespace", "  Source 2  ", "source-2"},
+		{"Empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := slugify(test.in); got != test.wantSlug {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantSlug)
+			}
+		})
+	}
+}
+
 func TestGameEngineService_Fields(t *testing.T) {
 	var tests = []struct {
 		name       string
@@ -201,7 +316,7 @@ func TestGameEngineService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.GameEngines.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
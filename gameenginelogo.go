@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -20,14 +21,23 @@ type GameEngineLogoService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameEngineLogos, an error is returned.
 func (gs *GameEngineLogoService) Get(id int, opts ...Option) (*GameEngineLogo, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameEngineLogo identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// GameEngineLogos, an error is returned.
+func (gs *GameEngineLogoService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameEngineLogo, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var logo []*GameEngineLogo
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &logo, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameEngineLogo with ID %v", id)
 	}
@@ -40,6 +50,15 @@ func (gs *GameEngineLogoService) Get(id int, opts ...Option) (*GameEngineLogo, e
 // Any ID that does not match a GameEngineLogo is ignored. If none of the IDs
 // match a GameEngineLogo, an error is returned.
 func (gs *GameEngineLogoService) List(ids []int, opts ...Option) ([]*GameEngineLogo, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameEngineLogos identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a GameEngineLogo is ignored.
+// If none of the IDs match a GameEngineLogo, an error is returned.
+func (gs *GameEngineLogoService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameEngineLogo, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -53,7 +72,7 @@ func (gs *GameEngineLogoService) List(ids []int, opts ...Option) ([]*GameEngineL
 	var logo []*GameEngineLogo
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &logo, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameEngineLogos with IDs %v", ids)
 	}
@@ -65,9 +84,18 @@ func (gs *GameEngineLogoService) List(ids []int, opts ...Option) ([]*GameEngineL
 // options used to sort, filter, and paginate the results. If no GameEngineLogos can
 // be found using the provided options, an error is returned.
 func (gs *GameEngineLogoService) Index(opts ...Option) ([]*GameEngineLogo, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameEngineLogos based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no GameEngineLogos can be found using the provided options,
+// an error is returned.
+func (gs *GameEngineLogoService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameEngineLogo, error) {
 	var logo []*GameEngineLogo
 
-	err := gs.client.post(gs.end, &logo, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameEngineLogos")
 	}
@@ -79,7 +107,15 @@ func (gs *GameEngineLogoService) Index(opts ...Option) ([]*GameEngineLogo, error
 // Provide the SetFilter functional option if you need to filter
 // which GameEngineLogos to count.
 func (gs *GameEngineLogoService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameEngineLogos available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which GameEngineLogos to count.
+func (gs *GameEngineLogoService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameEngineLogos")
 	}
@@ -90,7 +126,14 @@ func (gs *GameEngineLogoService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameEngineLogo object.
 func (gs *GameEngineLogoService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// GameEngineLogo object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (gs *GameEngineLogoService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameEngineLogo fields")
 	}
@@ -0,0 +1,171 @@
+package igdb
+
+import (
+	"context"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct GameLocalization -add-tags json -w
+
+// GameLocalization represents the localized name and cover
+// of a particular video game in a specific region.
+// For more information visit: https://api-docs.igdb.com/#game-localization
+type GameLocalization struct {
+	ID        int       `json:"id"`
+	Cover     int       `json:"cover"`
+	CreatedAt Timestamp `json:"created_at"`
+	Game      int       `json:"game"`
+	Name      string    `json:"name"`
+	Region    int       `json:"region"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// GameLocalizationService handles all the API calls for the IGDB GameLocalization endpoint.
+type GameLocalizationService service
+
+// Get returns a single GameLocalization identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any GameLocalizations, an error is returned.
+func (ls *GameLocalizationService) Get(id int, opts ...Option) (*GameLocalization, error) {
+	return ls.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameLocalization identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// GameLocalizations, an error is returned.
+func (ls *GameLocalizationService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameLocalization, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var loc []*GameLocalization
+
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ls.client.postWithContext(ctx, ls.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalization with ID %v", id)
+	}
+
+	return loc[0], nil
+}
+
+// List returns a list of GameLocalizations identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a GameLocalization is ignored. If none of the IDs
+// match a GameLocalization, an error is returned.
+func (ls *GameLocalizationService) List(ids []int, opts ...Option) ([]*GameLocalization, error) {
+	return ls.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameLocalizations identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// GameLocalization is ignored. If none of the IDs match a GameLocalization, an
+// error is returned.
+func (ls *GameLocalizationService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameLocalization, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var loc []*GameLocalization
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ls.client.postWithContext(ctx, ls.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalizations with IDs %v", ids)
+	}
+
+	return loc, nil
+}
+
+// GetByGame returns the list of GameLocalizations belonging to the Game
+// identified by the provided IGDB ID. Provide functional options to sort,
+// filter, and paginate the results. If the Game has no GameLocalizations,
+// an error is returned.
+func (ls *GameLocalizationService) GetByGame(gameID int, opts ...Option) ([]*GameLocalization, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var loc []*GameLocalization
+
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := ls.client.post(ls.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalizations with Game ID %v", gameID)
+	}
+
+	return loc, nil
+}
+
+// Index returns an index of GameLocalizations based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no GameLocalizations can
+// be found using the provided options, an error is returned.
+func (ls *GameLocalizationService) Index(opts ...Option) ([]*GameLocalization, error) {
+	return ls.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameLocalizations based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no GameLocalizations can be found using the provided options,
+// an error is returned.
+func (ls *GameLocalizationService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameLocalization, error) {
+	var loc []*GameLocalization
+
+	err := ls.client.postWithContext(ctx, ls.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of GameLocalizations")
+	}
+
+	return loc, nil
+}
+
+// Count returns the number of GameLocalizations available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which GameLocalizations to count.
+func (ls *GameLocalizationService) Count(opts ...Option) (int, error) {
+	return ls.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameLocalizations available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which GameLocalizations to count.
+func (ls *GameLocalizationService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ls.client.getCountWithContext(ctx, ls.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count GameLocalizations")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB GameLocalization object.
+func (ls *GameLocalizationService) Fields() ([]string, error) {
+	return ls.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// GameLocalization object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ls *GameLocalizationService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ls.client.getFieldsWithContext(ctx, ls.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get GameLocalization fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,260 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testGameLocalizationGet  string = "test_data/gamelocalization_get.json"
+	testGameLocalizationList string = "test_data/gamelocalization_list.json"
+)
+
+func TestGameLocalizationService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name    string
+		file    string
+		id      int
+		opts    []Option
+		wantLoc *GameLocalization
+		wantErr error
+	}{
+		{"Valid response", testGameLocalizationGet, 13, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 13, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 13, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.Get(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLoc) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLoc)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		ids      []int
+		opts     []Option
+		wantLocs []*GameLocalization
+		wantErr  error
+	}{
+		{"Valid response", testGameLocalizationList, []int{24, 26, 4, 15, 31}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{26, 4, 15, 31}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{26, 4, 15, 31}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.List(test.ids, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLocs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLocs)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_GetByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		id       int
+		opts     []Option
+		wantLocs []*GameLocalization
+		wantErr  error
+	}{
+		{"Valid response", testGameLocalizationList, 1942, []Option{SetLimit(5)}, init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.GetByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLocs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLocs)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		file     string
+		opts     []Option
+		wantLocs []*GameLocalization
+		wantErr  error
+	}{
+		{"Valid response", testGameLocalizationList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.Index(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantLocs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantLocs)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.GameLocalizations.Count(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.GameLocalizations.Fields()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,11 +12,12 @@ import (
 // GameMode represents a video game mode such as single or multi player.
 // For more information visit: https://api-docs.igdb.com/#game-mode
 type GameMode struct {
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // GameModeService handles all the API calls for the IGDB GameMode endpoint.
@@ -25,14 +27,23 @@ type GameModeService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameModes, an error is returned.
 func (gs *GameModeService) Get(id int, opts ...Option) (*GameMode, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameMode identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any GameModes, an error is
+// returned.
+func (gs *GameModeService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameMode, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var mode []*GameMode
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &mode, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameMode with ID %v", id)
 	}
@@ -45,6 +56,15 @@ func (gs *GameModeService) Get(id int, opts ...Option) (*GameMode, error) {
 // Any ID that does not match a GameMode is ignored. If none of the IDs
 // match a GameMode, an error is returned.
 func (gs *GameModeService) List(ids []int, opts ...Option) ([]*GameMode, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameModes identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a GameMode is ignored. If
+// none of the IDs match a GameMode, an error is returned.
+func (gs *GameModeService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameMode, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -58,7 +78,7 @@ func (gs *GameModeService) List(ids []int, opts ...Option) ([]*GameMode, error)
 	var mode []*GameMode
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &mode, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameModes with IDs %v", ids)
 	}
@@ -67,12 +87,26 @@ func (gs *GameModeService) List(ids []int, opts ...Option) ([]*GameMode, error)
 }
 
 // Index returns an index of GameModes based solely on the provided functional
-// options used to sort, filter, and paginate the results. If no GameModes can
-// be found using the provided options, an error is returned.
+// options used to sort, filter, and paginate the results. Unless otherwise
+// specified, every field of up to 500 GameModes is retrieved so that calling
+// Index without any options returns the full list of GameModes. If no GameModes
+// can be found using the provided options, an error is returned.
 func (gs *GameModeService) Index(opts ...Option) ([]*GameMode, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameModes based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Unless otherwise specified, every field of up to 500 GameModes is
+// retrieved so that calling Index without any options returns the full list of
+// GameModes. If no GameModes can be found using the provided options, an error
+// is returned.
+func (gs *GameModeService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameMode, error) {
 	var mode []*GameMode
 
-	err := gs.client.post(gs.end, &mode, opts...)
+	opts = withIndexDefaults(opts)
+	err := gs.client.postWithContext(ctx, gs.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameModes")
 	}
@@ -84,7 +118,15 @@ func (gs *GameModeService) Index(opts ...Option) ([]*GameMode, error) {
 // Provide the SetFilter functional option if you need to filter
 // which GameModes to count.
 func (gs *GameModeService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameModes available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// GameModes to count.
+func (gs *GameModeService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameModes")
 	}
@@ -95,7 +137,14 @@ func (gs *GameModeService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameMode object.
 func (gs *GameModeService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB GameMode
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (gs *GameModeService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameMode fields")
 	}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,12 +12,12 @@ import (
 // GameVersion provides details about game editions and versions.
 // For more information visit: https://api-docs.igdb.com/#game-version
 type GameVersion struct {
-	CreatedAt int    `json:"created_at"`
-	Features  []int  `json:"features"`
-	Game      int    `json:"game"`
-	Games     []int  `json:"games"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	CreatedAt Timestamp `json:"created_at"`
+	Features  []int     `json:"features"`
+	Game      int       `json:"game"`
+	Games     []int     `json:"games"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // GameVersionService handles all the API calls for the IGDB GameVersion endpoint.
@@ -26,14 +27,23 @@ type GameVersionService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameVersions, an error is returned.
 func (gs *GameVersionService) Get(id int, opts ...Option) (*GameVersion, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameVersion identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any GameVersions,
+// an error is returned.
+func (gs *GameVersionService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameVersion, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var ver []*GameVersion
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &ver, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersion with ID %v", id)
 	}
@@ -46,6 +56,15 @@ func (gs *GameVersionService) Get(id int, opts ...Option) (*GameVersion, error)
 // Any ID that does not match a GameVersion is ignored. If none of the IDs
 // match a GameVersion, an error is returned.
 func (gs *GameVersionService) List(ids []int, opts ...Option) ([]*GameVersion, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameVersions identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a GameVersion is ignored. If
+// none of the IDs match a GameVersion, an error is returned.
+func (gs *GameVersionService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersion, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -59,7 +78,7 @@ func (gs *GameVersionService) List(ids []int, opts ...Option) ([]*GameVersion, e
 	var ver []*GameVersion
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &ver, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersions with IDs %v", ids)
 	}
@@ -71,9 +90,18 @@ func (gs *GameVersionService) List(ids []int, opts ...Option) ([]*GameVersion, e
 // options used to sort, filter, and paginate the results. If no GameVersions can
 // be found using the provided options, an error is returned.
 func (gs *GameVersionService) Index(opts ...Option) ([]*GameVersion, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameVersions based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no GameVersions can be found using the provided options, an
+// error is returned.
+func (gs *GameVersionService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameVersion, error) {
 	var ver []*GameVersion
 
-	err := gs.client.post(gs.end, &ver, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameVersions")
 	}
@@ -85,7 +113,15 @@ func (gs *GameVersionService) Index(opts ...Option) ([]*GameVersion, error) {
 // Provide the SetFilter functional option if you need to filter
 // which GameVersions to count.
 func (gs *GameVersionService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameVersions available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which GameVersions to count.
+func (gs *GameVersionService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameVersions")
 	}
@@ -96,7 +132,14 @@ func (gs *GameVersionService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameVersion object.
 func (gs *GameVersionService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// GameVersion object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (gs *GameVersionService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameVersion fields")
 	}
@@ -49,7 +49,7 @@ func TestGameVersionService_Get(t *testing.T) {
 			defer ts.Close()
 
 			ver, err := c.GameVersions.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestGameVersionService_List(t *testing.T) {
 			defer ts.Close()
 
 			ver, err := c.GameVersions.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestGameVersionService_Index(t *testing.T) {
 			defer ts.Close()
 
 			ver, err := c.GameVersions.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestGameVersionService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.GameVersions.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestGameVersionService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.GameVersions.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
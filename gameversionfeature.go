@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -38,14 +39,23 @@ type GameVersionFeatureService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameVersionFeatures, an error is returned.
 func (gs *GameVersionFeatureService) Get(id int, opts ...Option) (*GameVersionFeature, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameVersionFeature identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// GameVersionFeatures, an error is returned.
+func (gs *GameVersionFeatureService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameVersionFeature, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var ft []*GameVersionFeature
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &ft, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &ft, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersionFeature with ID %v", id)
 	}
@@ -58,6 +68,16 @@ func (gs *GameVersionFeatureService) Get(id int, opts ...Option) (*GameVersionFe
 // Any ID that does not match a GameVersionFeature is ignored. If none of the IDs
 // match a GameVersionFeature, an error is returned.
 func (gs *GameVersionFeatureService) List(ids []int, opts ...Option) ([]*GameVersionFeature, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameVersionFeatures identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// GameVersionFeature is ignored. If none of the IDs match a GameVersionFeature,
+// an error is returned.
+func (gs *GameVersionFeatureService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersionFeature, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -71,7 +91,7 @@ func (gs *GameVersionFeatureService) List(ids []int, opts ...Option) ([]*GameVer
 	var ft []*GameVersionFeature
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &ft, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &ft, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersionFeatures with IDs %v", ids)
 	}
@@ -83,9 +103,18 @@ func (gs *GameVersionFeatureService) List(ids []int, opts ...Option) ([]*GameVer
 // options used to sort, filter, and paginate the results. If no GameVersionFeatures can
 // be found using the provided options, an error is returned.
 func (gs *GameVersionFeatureService) Index(opts ...Option) ([]*GameVersionFeature, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameVersionFeatures based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no GameVersionFeatures can be found using the provided
+// options, an error is returned.
+func (gs *GameVersionFeatureService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameVersionFeature, error) {
 	var ft []*GameVersionFeature
 
-	err := gs.client.post(gs.end, &ft, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &ft, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameVersionFeatures")
 	}
@@ -97,7 +126,15 @@ func (gs *GameVersionFeatureService) Index(opts ...Option) ([]*GameVersionFeatur
 // Provide the SetFilter functional option if you need to filter
 // which GameVersionFeatures to count.
 func (gs *GameVersionFeatureService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameVersionFeatures available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which GameVersionFeatures to count.
+func (gs *GameVersionFeatureService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameVersionFeatures")
 	}
@@ -108,7 +145,14 @@ func (gs *GameVersionFeatureService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameVersionFeature object.
 func (gs *GameVersionFeatureService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// GameVersionFeature object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (gs *GameVersionFeatureService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameVersionFeature fields")
 	}
@@ -49,7 +49,7 @@ func TestGameVersionFeatureService_Get(t *testing.T) {
 			defer ts.Close()
 
 			ft, err := c.GameVersionFeatures.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestGameVersionFeatureService_List(t *testing.T) {
 			defer ts.Close()
 
 			ft, err := c.GameVersionFeatures.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestGameVersionFeatureService_Index(t *testing.T) {
 			defer ts.Close()
 
 			ft, err := c.GameVersionFeatures.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestGameVersionFeatureService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.GameVersionFeatures.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestGameVersionFeatureService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.GameVersionFeatures.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -37,14 +38,23 @@ type GameVersionFeatureValueService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameVersionFeatureValues, an error is returned.
 func (gs *GameVersionFeatureValueService) Get(id int, opts ...Option) (*GameVersionFeatureValue, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameVersionFeatureValue identified by the
+// provided IGDB ID. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide the SetFields functional option if you
+// need to specify which fields to retrieve. If the ID does not match any
+// GameVersionFeatureValues, an error is returned.
+func (gs *GameVersionFeatureValueService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameVersionFeatureValue, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var val []*GameVersionFeatureValue
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &val, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &val, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersionFeatureValue with ID %v", id)
 	}
@@ -57,6 +67,16 @@ func (gs *GameVersionFeatureValueService) Get(id int, opts ...Option) (*GameVers
 // Any ID that does not match a GameVersionFeatureValue is ignored. If none of the IDs
 // match a GameVersionFeatureValue, an error is returned.
 func (gs *GameVersionFeatureValueService) List(ids []int, opts ...Option) ([]*GameVersionFeatureValue, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameVersionFeatureValues identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// GameVersionFeatureValue is ignored. If none of the IDs match a
+// GameVersionFeatureValue, an error is returned.
+func (gs *GameVersionFeatureValueService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVersionFeatureValue, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -70,7 +90,7 @@ func (gs *GameVersionFeatureValueService) List(ids []int, opts ...Option) ([]*Ga
 	var val []*GameVersionFeatureValue
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &val, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &val, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVersionFeatureValues with IDs %v", ids)
 	}
@@ -82,9 +102,18 @@ func (gs *GameVersionFeatureValueService) List(ids []int, opts ...Option) ([]*Ga
 // options used to sort, filter, and paginate the results. If no GameVersionFeatureValues can
 // be found using the provided options, an error is returned.
 func (gs *GameVersionFeatureValueService) Index(opts ...Option) ([]*GameVersionFeatureValue, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameVersionFeatureValues based solely on
+// the provided functional options used to sort, filter, and paginate the
+// results. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. If no GameVersionFeatureValues can be found using the
+// provided options, an error is returned.
+func (gs *GameVersionFeatureValueService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameVersionFeatureValue, error) {
 	var val []*GameVersionFeatureValue
 
-	err := gs.client.post(gs.end, &val, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &val, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameVersionFeatureValues")
 	}
@@ -96,7 +125,15 @@ func (gs *GameVersionFeatureValueService) Index(opts ...Option) ([]*GameVersionF
 // Provide the SetFilter functional option if you need to filter
 // which GameVersionFeatureValues to count.
 func (gs *GameVersionFeatureValueService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameVersionFeatureValues available in
+// the IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which GameVersionFeatureValues to count.
+func (gs *GameVersionFeatureValueService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameVersionFeatureValues")
 	}
@@ -107,7 +144,14 @@ func (gs *GameVersionFeatureValueService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameVersionFeatureValue object.
 func (gs *GameVersionFeatureValueService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// GameVersionFeatureValue object. The request is canceled if the provided
+// context is canceled or its deadline is exceeded.
+func (gs *GameVersionFeatureValueService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameVersionFeatureValue fields")
 	}
@@ -49,7 +49,7 @@ func TestGameVersionFeatureValueService_Get(t *testing.T) {
 			defer ts.Close()
 
 			val, err := c.GameVersionFeatureValues.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestGameVersionFeatureValueService_List(t *testing.T) {
 			defer ts.Close()
 
 			val, err := c.GameVersionFeatureValues.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestGameVersionFeatureValueService_Index(t *testing.T) {
 			defer ts.Close()
 
 			val, err := c.GameVersionFeatureValues.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestGameVersionFeatureValueService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.GameVersionFeatureValues.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestGameVersionFeatureValueService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.GameVersionFeatureValues.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,11 +12,38 @@ import (
 // GameVideo represents a video associated with a particular game.
 // For more information visit: https://api-docs.igdb.com/#game-video
 type GameVideo struct {
+	ID      int    `json:"id"`
 	Game    int    `json:"game"`
 	Name    string `json:"name"`
 	VideoID string `json:"video_id"`
 }
 
+// YouTube URLs used to build the links of a GameVideo.
+const (
+	youtubeWatchURL = "https://www.youtube.com/watch?v="
+	youtubeEmbedURL = "https://www.youtube.com/embed/"
+)
+
+// YouTubeURL returns the URL of the YouTube page hosting the GameVideo.
+// If the GameVideo has no video ID, an empty string is returned.
+func (gv GameVideo) YouTubeURL() string {
+	if gv.VideoID == "" {
+		return ""
+	}
+
+	return youtubeWatchURL + gv.VideoID
+}
+
+// EmbedURL returns the URL used to embed the GameVideo's YouTube player.
+// If the GameVideo has no video ID, an empty string is returned.
+func (gv GameVideo) EmbedURL() string {
+	if gv.VideoID == "" {
+		return ""
+	}
+
+	return youtubeEmbedURL + gv.VideoID
+}
+
 // GameVideoService handles all the API calls for the IGDB GameVideo endpoint.
 type GameVideoService service
 
@@ -23,14 +51,23 @@ type GameVideoService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any GameVideos, an error is returned.
 func (gs *GameVideoService) Get(id int, opts ...Option) (*GameVideo, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single GameVideo identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any GameVideos, an error
+// is returned.
+func (gs *GameVideoService) GetWithContext(ctx context.Context, id int, opts ...Option) (*GameVideo, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var vid []*GameVideo
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &vid, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &vid, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVideo with ID %v", id)
 	}
@@ -43,6 +80,15 @@ func (gs *GameVideoService) Get(id int, opts ...Option) (*GameVideo, error) {
 // Any ID that does not match a GameVideo is ignored. If none of the IDs
 // match a GameVideo, an error is returned.
 func (gs *GameVideoService) List(ids []int, opts ...Option) ([]*GameVideo, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of GameVideos identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a GameVideo is ignored. If
+// none of the IDs match a GameVideo, an error is returned.
+func (gs *GameVideoService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*GameVideo, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -56,7 +102,7 @@ func (gs *GameVideoService) List(ids []int, opts ...Option) ([]*GameVideo, error
 	var vid []*GameVideo
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &vid, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &vid, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get GameVideos with IDs %v", ids)
 	}
@@ -64,13 +110,43 @@ func (gs *GameVideoService) List(ids []int, opts ...Option) ([]*GameVideo, error
 	return vid, nil
 }
 
+// ListByGame returns the list of GameVideos belonging to the Game identified by
+// the provided IGDB ID. Up to 500 GameVideos are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no GameVideos, an error is returned.
+func (gs *GameVideoService) ListByGame(gameID int, opts ...Option) ([]*GameVideo, error) {
+	if gameID < 1 {
+		return nil, ErrNonPositiveID
+	}
+
+	var vid []*GameVideo
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := gs.client.post(gs.end, &vid, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameVideos with Game ID %v", gameID)
+	}
+
+	return vid, nil
+}
+
 // Index returns an index of GameVideos based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no GameVideos can
 // be found using the provided options, an error is returned.
 func (gs *GameVideoService) Index(opts ...Option) ([]*GameVideo, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of GameVideos based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no GameVideos can be found using the provided options, an error
+// is returned.
+func (gs *GameVideoService) IndexWithContext(ctx context.Context, opts ...Option) ([]*GameVideo, error) {
 	var vid []*GameVideo
 
-	err := gs.client.post(gs.end, &vid, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &vid, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of GameVideos")
 	}
@@ -82,7 +158,15 @@ func (gs *GameVideoService) Index(opts ...Option) ([]*GameVideo, error) {
 // Provide the SetFilter functional option if you need to filter
 // which GameVideos to count.
 func (gs *GameVideoService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of GameVideos available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// GameVideos to count.
+func (gs *GameVideoService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count GameVideos")
 	}
@@ -93,7 +177,14 @@ func (gs *GameVideoService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB GameVideo object.
 func (gs *GameVideoService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB GameVideo
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (gs *GameVideoService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get GameVideo fields")
 	}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestGameVideoService_Get(t *testing.T) {
 			defer ts.Close()
 
 			vid, err := c.GameVideos.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestGameVideoService_List(t *testing.T) {
 			defer ts.Close()
 
 			vid, err := c.GameVideos.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +108,69 @@ func TestGameVideoService_List(t *testing.T) {
 	}
 }
 
+func TestGameVideoService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameVideoList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameVideo, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		id             int
+		opts           []Option
+		wantLim        string
+		wantGameVideos []*GameVideo
+		wantErr        error
+	}{
+		{"Valid response", testGameVideoList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testGameVideoList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Negative ID", testFileEmpty, -1, nil, "", nil, ErrNonPositiveID},
+		{"Zero ID", testFileEmpty, 0, nil, "", nil, ErrNonPositiveID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 1942, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			vid, err := c.GameVideos.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(vid, test.wantGameVideos) {
+				t.Errorf("got: <%v>, \nwant: <%v>", vid, test.wantGameVideos)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
 func TestGameVideoService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameVideoList)
 	if err != nil {
@@ -140,7 +204,7 @@ func TestGameVideoService_Index(t *testing.T) {
 			defer ts.Close()
 
 			vid, err := c.GameVideos.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +235,7 @@ func TestGameVideoService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.GameVideos.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +266,7 @@ func TestGameVideoService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.GameVideos.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -212,3 +276,27 @@ func TestGameVideoService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestGameVideo_URL(t *testing.T) {
+	var tests = []struct {
+		name      string
+		vid       GameVideo
+		wantURL   string
+		wantEmbed string
+	}{
+		{"Video ID", GameVideo{VideoID: "T1TOkHkFaoc"}, "https://www.youtube.com/watch?v=T1TOkHkFaoc", "https://www.youtube.com/embed/T1TOkHkFaoc"},
+		{"Empty video ID", GameVideo{}, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.vid.YouTubeURL() != test.wantURL {
+				t.Errorf("got: <%v>, want: <%v>", test.vid.YouTubeURL(), test.wantURL)
+			}
+
+			if test.vid.EmbedURL() != test.wantEmbed {
+				t.Errorf("got: <%v>, want: <%v>", test.vid.EmbedURL(), test.wantEmbed)
+			}
+		})
+	}
+}
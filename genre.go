@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,12 +12,12 @@ import (
 // Genre represents the genre of a particular video game.
 // For more information visit: https://api-docs.igdb.com/#genre
 type Genre struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // GenreService handles all the API calls for the IGDB Genre endpoint.
@@ -26,14 +27,23 @@ type GenreService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Genres, an error is returned.
 func (gs *GenreService) Get(id int, opts ...Option) (*Genre, error) {
+	return gs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Genre identified by the provided IGDB ID. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Genres, an error is
+// returned.
+func (gs *GenreService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Genre, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var gen []*Genre
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := gs.client.post(gs.end, &gen, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.postWithContext(ctx, gs.end, &gen, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Genre with ID %v", id)
 	}
@@ -46,6 +56,15 @@ func (gs *GenreService) Get(id int, opts ...Option) (*Genre, error) {
 // Any ID that does not match a Genre is ignored. If none of the IDs
 // match a Genre, an error is returned.
 func (gs *GenreService) List(ids []int, opts ...Option) ([]*Genre, error) {
+	return gs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Genres identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Genre is ignored. If none
+// of the IDs match a Genre, an error is returned.
+func (gs *GenreService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Genre, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -59,7 +78,7 @@ func (gs *GenreService) List(ids []int, opts ...Option) ([]*Genre, error) {
 	var gen []*Genre
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := gs.client.post(gs.end, &gen, opts...)
+	err := gs.client.postWithContext(ctx, gs.end, &gen, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Genres with IDs %v", ids)
 	}
@@ -68,12 +87,26 @@ func (gs *GenreService) List(ids []int, opts ...Option) ([]*Genre, error) {
 }
 
 // Index returns an index of Genres based solely on the provided functional
-// options used to sort, filter, and paginate the results. If no Genres can
-// be found using the provided options, an error is returned.
+// options used to sort, filter, and paginate the results. Unless otherwise
+// specified, every field of up to 500 Genres is retrieved so that calling
+// Index without any options returns the full list of Genres. If no Genres
+// can be found using the provided options, an error is returned.
 func (gs *GenreService) Index(opts ...Option) ([]*Genre, error) {
+	return gs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Genres based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Unless otherwise specified, every field of up to 500 Genres is
+// retrieved so that calling Index without any options returns the full list of
+// Genres. If no Genres can be found using the provided options, an error is
+// returned.
+func (gs *GenreService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Genre, error) {
 	var gen []*Genre
 
-	err := gs.client.post(gs.end, &gen, opts...)
+	opts = withIndexDefaults(opts)
+	err := gs.client.postWithContext(ctx, gs.end, &gen, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Genres")
 	}
@@ -85,7 +118,15 @@ func (gs *GenreService) Index(opts ...Option) ([]*Genre, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Genres to count.
 func (gs *GenreService) Count(opts ...Option) (int, error) {
-	ct, err := gs.client.getCount(gs.end, opts...)
+	return gs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Genres available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Genres to count.
+func (gs *GenreService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := gs.client.getCountWithContext(ctx, gs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Genres")
 	}
@@ -96,7 +137,14 @@ func (gs *GenreService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Genre object.
 func (gs *GenreService) Fields() ([]string, error) {
-	f, err := gs.client.getFields(gs.end)
+	return gs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Genre
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (gs *GenreService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := gs.client.getFieldsWithContext(ctx, gs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Genre fields")
 	}
@@ -8,4 +8,5 @@ require (
 	github.com/Henry-Sarabia/igdb v1.0.3
 	github.com/Henry-Sarabia/sliceconv v1.0.2
 	github.com/pkg/errors v0.9.1
+	golang.org/x/sync v0.1.0
 )
@@ -0,0 +1,107 @@
+package igdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RequestHook is called with each request sent by a Client.
+type RequestHook func(req *http.Request)
+
+// ResponseHook is called with each response received by a Client, along with
+// its raw body and the time elapsed since the request was sent.
+type ResponseHook func(resp *http.Response, body []byte, elapsed time.Duration)
+
+// OnRequest registers the provided hook to be called with every request the
+// Client sends to the IGDB. Hooks are called in the order they are registered
+// and receive a copy of the request, so reading its body does not affect the
+// request itself. OnRequest should be called before the Client is used.
+func (c *Client) OnRequest(hook RequestHook) {
+	if hook == nil {
+		return
+	}
+
+	c.onRequest = append(c.onRequest, hook)
+}
+
+// OnResponse registers the provided hook to be called with every response the
+// Client receives from the IGDB, including error responses. Hooks are called
+// in the order they are registered and receive copies of the response and its
+// body, so modifying either does not affect the results of the API call.
+// OnResponse should be called before the Client is used.
+func (c *Client) OnResponse(hook ResponseHook) {
+	if hook == nil {
+		return
+	}
+
+	c.onResponse = append(c.onResponse, hook)
+}
+
+// EnableDebugLogging registers hooks that write the method, URL, and body of
+// every request and the status, elapsed time, and body of every response to
+// the provided writer. Authorization headers are never written.
+// EnableDebugLogging should be called before the Client is used.
+func (c *Client) EnableDebugLogging(w io.Writer) {
+	var mu sync.Mutex
+
+	c.OnRequest(func(req *http.Request) {
+		var body []byte
+		if req.Body != nil {
+			body, _ = ioutil.ReadAll(req.Body)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "igdb: request: %s %s\n%s\n", req.Method, req.URL, body)
+	})
+
+	c.OnResponse(func(resp *http.Response, body []byte, elapsed time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "igdb: response: %s in %v\n%s\n", resp.Status, elapsed, body)
+	})
+}
+
+// callRequestHooks calls each of the Client's request hooks with a copy of
+// the provided request.
+func (c *Client) callRequestHooks(req *http.Request) {
+	for _, hook := range c.onRequest {
+		cp := req.Clone(req.Context())
+		cp.Body = nil
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				cp.Body = body
+			}
+		}
+
+		hook(cp)
+	}
+}
+
+// callResponseHooks reads the body of the provided response and calls each
+// of the Client's response hooks with copies of the response and its body.
+// The body of the provided response is replaced so it can still be read.
+func (c *Client) callResponseHooks(resp *http.Response, elapsed time.Duration) error {
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	for _, hook := range c.onResponse {
+		cp := *resp
+		cp.Header = resp.Header.Clone()
+		body := append([]byte(nil), b...)
+		cp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		hook(&cp, body, elapsed)
+	}
+
+	return nil
+}
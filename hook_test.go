@@ -0,0 +1,120 @@
+package igdb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestClient_Hooks(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		resp     string
+		wantBody string
+		wantErr  error
+	}{
+		{"Status OK", http.StatusOK, testResult, testResult, nil},
+		{"Status Bad Request", http.StatusBadRequest, `[{"title": "Syntax Error"}]`, `[{"title": "Syntax Error"}]`, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, test.resp)
+			defer ts.Close()
+
+			var calls []string
+			var gotQry string
+			var gotBody []byte
+			var gotStatus int
+
+			c.OnRequest(func(req *http.Request) {
+				calls = append(calls, "request 1")
+				b, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotQry = string(b)
+			})
+			c.OnRequest(func(req *http.Request) {
+				calls = append(calls, "request 2")
+			})
+			c.OnResponse(func(resp *http.Response, body []byte, elapsed time.Duration) {
+				calls = append(calls, "response 1")
+				gotStatus = resp.StatusCode
+				gotBody = append([]byte(nil), body...)
+
+				// Modifying the copies must not affect the results.
+				for i := range body {
+					body[i] = 'x'
+				}
+				resp.Body = ioutil.NopCloser(strings.NewReader("garbage"))
+			})
+			c.OnResponse(func(resp *http.Response, body []byte, elapsed time.Duration) {
+				calls = append(calls, "response 2")
+				if string(body) != test.wantBody {
+					t.Errorf("got: <%s>, want: <%s>", body, test.wantBody)
+				}
+			})
+			c.OnRequest(nil)
+			c.OnResponse(nil)
+
+			res := testResultPlaceholder{}
+
+			err := c.post(testEndpoint, &res, SetLimit(5))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr == nil && res.SomeField != "some_value" {
+				t.Errorf("got: <%v>, want: <%v>", res.SomeField, "some_value")
+			}
+
+			wantCalls := []string{"request 1", "request 2", "response 1", "response 2"}
+			if !reflect.DeepEqual(calls, wantCalls) {
+				t.Errorf("got: <%v>, want: <%v>", calls, wantCalls)
+			}
+
+			if gotQry != "limit 5; " {
+				t.Errorf("got: <%v>, want: <%v>", gotQry, "limit 5; ")
+			}
+
+			if gotStatus != test.status {
+				t.Errorf("got: <%v>, want: <%v>", gotStatus, test.status)
+			}
+
+			if string(gotBody) != test.wantBody {
+				t.Errorf("got: <%s>, want: <%s>", gotBody, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestClient_EnableDebugLogging(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, testResult)
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	c.EnableDebugLogging(&buf)
+
+	res := testResultPlaceholder{}
+	if err := c.post(testEndpoint, &res, SetLimit(5)); err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{"igdb: request: POST " + ts.URL, "limit 5; ", "igdb: response: 200 OK", "some_value"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got: <%v>, want to contain: <%v>", got, want)
+		}
+	}
+
+	if strings.Contains(got, testToken) {
+		t.Errorf("got: <%v>, want log without token", got)
+	}
+}
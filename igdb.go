@@ -1,17 +1,29 @@
 package igdb
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
-	"io/ioutil"
+	"io"
 	"net/http"
+	"reflect"
+	"strings"
+	"time"
 
-	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/Henry-Sarabia/blank"
 	"github.com/pkg/errors"
 )
 
 // igdbURL is the base URL for the IGDB API.
 const igdbURL string = "https://api.igdb.com/v4/"
 
+// igdbImageURL is the base URL for the IGDB images CDN.
+const igdbImageURL string = "https://images.igdb.com/igdb/image/upload/"
+
+// defaultUserAgent is the user agent sent with each request unless
+// another is provided using WithUserAgent.
+const defaultUserAgent string = "HenrySarabia/igdb"
+
 // service is the underlying struct that handles
 // all API calls for different IGDB endpoints.
 type service struct {
@@ -26,8 +38,30 @@ type service struct {
 type Client struct {
 	http     *http.Client
 	rootURL  string
+	imageURL string
 	clientID string
 	token    string
+	rate     rateCounter
+	limit    *limiter
+	retry    retryPolicy
+	auth     *tokenSource
+	cache    Cache
+	cacheTTL time.Duration
+
+	userAgent     string
+	header        http.Header
+	noCompression bool
+
+	onRequest  []RequestHook
+	onResponse []ResponseHook
+
+	chunkSize        int
+	chunkConcurrency int
+
+	coalesce bool
+	flights  flightGroup
+
+	recorder Recorder
 
 	// Services
 	AgeRatings                  *AgeRatingService
@@ -46,6 +80,7 @@ type Client struct {
 	Games                       *GameService
 	GameEngines                 *GameEngineService
 	GameEngineLogos             *GameEngineLogoService
+	GameLocalizations           *GameLocalizationService
 	GameModes                   *GameModeService
 	GameVersions                *GameVersionService
 	GameVersionFeatures         *GameVersionFeatureService
@@ -63,9 +98,14 @@ type Client struct {
 	PlatformWebsites            *PlatformWebsiteService
 	PlayerPerspectives          *PlayerPerspectiveService
 	PlatformFamilies            *PlatformFamilyService
+	Pulses                      *PulseService
+	PulseGroups                 *PulseGroupService
+	PulseSources                *PulseSourceService
 	ReleaseDates                *ReleaseDateService
 	Screenshots                 *ScreenshotService
+	Searches                    *SearchService
 	Themes                      *ThemeService
+	TimeToBeats                 *TimeToBeatService
 	Websites                    *WebsiteService
 }
 
@@ -76,15 +116,32 @@ type Client struct {
 //
 // If you need an IGDB/Twitch API keys, please visit: https://api-docs.igdb.com/#account-creation
 func NewClient(clientID string, appAccessToken string, custom *http.Client) *Client {
-	if custom == nil {
-		custom = http.DefaultClient
-	}
+	c, _ := New(clientID, appAccessToken, WithHTTPClient(custom))
+	return c
+}
 
+// New returns a new Client configured to communicate with the IGDB. The provided
+// clientID and appAccessToken will be used to make requests on your behalf.
+// Provide ClientOptions to customize the Client, such as its root URL or HTTP
+// client. The options are applied in order after the defaults. If any of the
+// options is invalid, an error is returned.
+//
+// If you need an IGDB/Twitch API keys, please visit: https://api-docs.igdb.com/#account-creation
+func New(clientID string, appAccessToken string, opts ...ClientOption) (*Client, error) {
 	c := &Client{
-		http:     custom,
-		rootURL:  igdbURL,
-		clientID: clientID,
-		token:    appAccessToken,
+		http:      http.DefaultClient,
+		rootURL:   igdbURL,
+		imageURL:  igdbImageURL,
+		clientID:  clientID,
+		token:     appAccessToken,
+		userAgent: defaultUserAgent,
+		header:    make(http.Header),
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, errors.Wrap(err, "cannot create client with invalid option")
+		}
 	}
 
 	c.AgeRatings = &AgeRatingService{client: c, end: EndpointAgeRating}
@@ -103,6 +160,7 @@ func NewClient(clientID string, appAccessToken string, custom *http.Client) *Cli
 	c.Games = &GameService{client: c, end: EndpointGame}
 	c.GameEngines = &GameEngineService{client: c, end: EndpointGameEngine}
 	c.GameEngineLogos = &GameEngineLogoService{client: c, end: EndpointGameEngineLogo}
+	c.GameLocalizations = &GameLocalizationService{client: c, end: EndpointGameLocalization}
 	c.GameModes = &GameModeService{client: c, end: EndpointGameMode}
 	c.GameVersions = &GameVersionService{client: c, end: EndpointGameVersion}
 	c.GameVersionFeatures = &GameVersionFeatureService{client: c, end: EndpointGameVersionFeature}
@@ -120,12 +178,17 @@ func NewClient(clientID string, appAccessToken string, custom *http.Client) *Cli
 	c.PlatformWebsites = &PlatformWebsiteService{client: c, end: EndpointPlatformWebsite}
 	c.PlayerPerspectives = &PlayerPerspectiveService{client: c, end: EndpointPlayerPerspective}
 	c.PlatformFamilies = &PlatformFamilyService{client: c, end: EndpointPlatformFamily}
+	c.Pulses = &PulseService{client: c, end: EndpointPulse}
+	c.PulseGroups = &PulseGroupService{client: c, end: EndpointPulseGroup}
+	c.PulseSources = &PulseSourceService{client: c, end: EndpointPulseSource}
 	c.ReleaseDates = &ReleaseDateService{client: c, end: EndpointReleaseDate}
 	c.Screenshots = &ScreenshotService{client: c, end: EndpointScreenshot}
+	c.Searches = &SearchService{client: c, end: EndpointSearch}
 	c.Themes = &ThemeService{client: c, end: EndpointTheme}
+	c.TimeToBeats = &TimeToBeatService{client: c, end: EndpointTimeToBeat}
 	c.Websites = &WebsiteService{client: c, end: EndpointWebsite}
 
-	return c
+	return c, nil
 }
 
 // Request configures a new request for the provided URL and
@@ -136,61 +199,368 @@ func (c *Client) request(end endpoint, opts ...Option) (*http.Request, error) {
 		return nil, errors.Wrap(err, "cannot create request with invalid options")
 	}
 
-	req, err := apicalypse.NewRequest("POST", c.rootURL+string(end), unwrapped...)
+	qry, err := query(unwrapped...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot make request for '%s' endpoint", end)
 	}
 
-	req.Header.Add("client-id", c.clientID)
-	req.Header.Add("Authorization", "Bearer "+c.token)
-	req.Header.Add("x-user-agent", "HenrySarabia/igdb")
-	req.Header.Add("Accept", "application/json")
+	return c.newRequest(end, qry)
+}
+
+// newRequest configures a new POST request for the provided endpoint with
+// the provided body and adds the necessary headers to communicate with the IGDB.
+func (c *Client) newRequest(end endpoint, body string) (*http.Request, error) {
+	req, err := c.newRequestWithMethod("POST", string(end), strings.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot make request for '%s' endpoint", end)
+	}
+
+	return req, nil
+}
+
+// newRequestWithMethod configures a new request with the provided method for
+// the provided path relative to the root URL and adds the necessary headers
+// to communicate with the IGDB. The body is sent as is, allowing requests that
+// are not apicalypse queries such as form-encoded webhook registrations.
+func (c *Client) newRequestWithMethod(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.rootURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range c.header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+
+	req.Header.Set("client-id", c.clientID)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("x-user-agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
+
+	if c.noCompression {
+		req.Header.Set("Accept-Encoding", "identity")
+	} else {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
 
 	return req, nil
 }
 
+// do sends the provided request using the Client's HTTP client. If the
+// request fails and the Client is configured to retry, the request is sent
+// again after a backoff until it succeeds or the attempts are exhausted.
+// Any request and response hooks are called once per call, after the
+// request's authentication headers have been set.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if len(c.onRequest) == 0 && len(c.onResponse) == 0 {
+		return c.withRetry(req, c.authorized)
+	}
+
+	if c.auth != nil {
+		tok, err := c.auth.get(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+	}
+
+	c.callRequestHooks(req)
+
+	start := time.Now()
+	resp, err := c.withRetry(req, c.authorized)
+	if err != nil || len(c.onResponse) == 0 {
+		return resp, err
+	}
+
+	if err := c.callResponseHooks(resp, time.Since(start)); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// withRetry sends the provided request using the provided send function. If
+// the request fails and the Client is configured to retry, the request is sent
+// again after a backoff until it succeeds or the attempts are exhausted.
+func (c *Client) withRetry(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := send(req)
+		if attempt >= c.retry.attempts || !shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := c.retry.backoff(attempt)
+		if resp != nil {
+			if ra := retryAfter(resp); ra > delay {
+				delay = ra
+			}
+		}
+		discard(resp)
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+		traceFrom(req.Context()).retries++
+	}
+}
+
+// try sends the provided request a single time using the Client's HTTP client
+// and counts the request against the rate limits. If the Client is rate
+// limited, try blocks until the request can be sent.
+func (c *Client) try(req *http.Request) (*http.Response, error) {
+	if c.limit != nil {
+		if err := c.limit.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	c.rate.record(time.Now())
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decompress(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	if c.limit != nil && resp.StatusCode == http.StatusTooManyRequests {
+		c.limit.pause(retryAfter(resp))
+	}
+
+	return resp, nil
+}
+
 // Send sends the provided request and stores the response in the value pointed to by result.
 // The response will be checked and return any errors.
 func (c *Client) send(req *http.Request, result interface{}) error {
-	resp, err := c.http.Do(req)
+	_, err := c.recorded(c.sendWithResponse)(req, result)
+	return err
+}
+
+// sendWithResponse sends the provided request and stores the response in the
+// value pointed to by result. The metadata of the response is returned even if
+// the response contains an error, as long as a response was received.
+func (c *Client) sendWithResponse(req *http.Request, result interface{}) (*Response, error) {
+	r, resp, err := c.receive(req)
 	if err != nil {
-		return errors.Wrap(err, "http client cannot send request")
+		return r, err
 	}
-	defer resp.Body.Close()
+	resp.Body = countingBody{ReadCloser: resp.Body, n: &traceFrom(req.Context()).size}
+	defer discard(resp)
 
-	if err = checkResponse(resp); err != nil {
-		return err
-	}
+	return r, decodeResults(resp.Body, result)
+}
 
-	b, err := ioutil.ReadAll(resp.Body)
+// receive sends the provided request, checks the response for errors, and
+// returns the metadata of the response along with the response itself. The
+// metadata is returned even if the response contains an error, as long as a
+// response was received. If no error is returned, the caller must close the
+// body of the response.
+func (c *Client) receive(req *http.Request) (*Response, *http.Response, error) {
+	resp, err := c.do(req)
 	if err != nil {
-		return errors.Wrap(err, "cannot read response body")
+		return nil, nil, errors.Wrap(err, "http client cannot send request")
+	}
+
+	r := newResponse(resp)
+
+	if err = checkResponse(resp); err != nil {
+		resp.Body.Close()
+		return r, nil, err
 	}
 
-	if isBracketPair(b) {
+	return r, resp, nil
+}
+
+// decodeResults decodes the results read from the provided response body into
+// the value pointed to by result. If the body is an empty array, ErrNoResults
+// is returned. The body is decoded as it is read rather than buffered in full.
+func decodeResults(body io.Reader, result interface{}) error {
+	br := bufio.NewReader(body)
+	dec := json.NewDecoder(br)
+
+	if isEmptyArray(br) {
+		// Consume the opening and closing brackets.
+		dec.Token()
+		dec.Token()
+		if err := checkTrailing(dec); err != nil {
+			return err
+		}
 		return ErrNoResults
 	}
 
-	err = json.Unmarshal(b, &result)
+	var err error
+	if b, _ := br.Peek(1); len(b) == 1 && b[0] == '[' && isSlicePtr(result) {
+		err = decodeArray(dec, reflect.ValueOf(result).Elem())
+	} else {
+		err = dec.Decode(&result)
+	}
 	if err != nil {
 		return errors.Wrap(errInvalidJSON, err.Error())
 	}
 
+	return checkTrailing(dec)
+}
+
+// isSlicePtr returns true if the provided value is a non-nil pointer to a slice.
+func isSlicePtr(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Slice
+}
+
+// decodeArray decodes the JSON array read by the provided decoder into the
+// provided slice one element at a time, so only a single element is held in
+// the decoder's buffer at once.
+func decodeArray(dec *json.Decoder, slice reflect.Value) error {
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	s := reflect.MakeSlice(slice.Type(), 0, 0)
+	for dec.More() {
+		elem := reflect.New(slice.Type().Elem())
+		if err := dec.Decode(elem.Interface()); err != nil {
+			return err
+		}
+		s = reflect.Append(s, elem.Elem())
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	slice.Set(s)
+	return nil
+}
+
+// checkTrailing returns an error if anything other than whitespace follows
+// the value read by the provided decoder.
+func checkTrailing(dec *json.Decoder) error {
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errors.New("invalid data after top-level value")
+		}
+		return errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	return nil
+}
+
+// isEmptyArray returns true if the JSON value at the start of the provided
+// reader is an empty array. Leading whitespace is consumed, but the value
+// itself is left unread. Arrays containing more whitespace than fits in the
+// reader's buffer are reported as not empty.
+func isEmptyArray(br *bufio.Reader) bool {
+	for {
+		b, err := br.Peek(1)
+		if err != nil || !isSpace(b[0]) {
+			break
+		}
+		br.ReadByte()
+	}
+
+	for n := 1; ; n++ {
+		b, err := br.Peek(n)
+		if err != nil {
+			return false
+		}
+
+		switch c := b[n-1]; {
+		case n == 1:
+			if c != '[' {
+				return false
+			}
+		case c == ']':
+			return true
+		case !isSpace(c):
+			return false
+		}
+	}
+}
+
+// isSpace returns true if the provided byte is insignificant JSON whitespace.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// GetRaw sends the provided apicalypse query string verbatim to the provided
+// endpoint and stores the results in the value pointed to by result. This is
+// useful for running a query copied from the IGDB documentation or for
+// reproducing a failing query exactly. The query is not validated beyond
+// being non-empty, so any errors in its syntax are reported by the IGDB.
+//
+// For more information, visit: https://api-docs.igdb.com/#apicalypse-1
+func (c *Client) GetRaw(end endpoint, qry string, result interface{}) error {
+	if blank.Is(qry) {
+		return ErrEmptyQry
+	}
+
+	req, err := c.newRequest(end, qry)
+	if err != nil {
+		return err
+	}
+
+	if err = c.send(req, result); err != nil {
+		return errors.Wrapf(err, "cannot make raw query to '%s' endpoint", end)
+	}
+
 	return nil
 }
 
 // post sends a POST request to the provided endpoint with the provided options and
 // stores the results in the value pointed to by result.
 func (c *Client) post(end endpoint, result interface{}, opts ...Option) error {
+	return c.postWithContext(context.Background(), end, result, opts...)
+}
+
+// postWithContext sends a POST request to the provided endpoint with the provided
+// options and stores the results in the value pointed to by result. The request is
+// canceled if the provided context is canceled or its deadline is exceeded.
+func (c *Client) postWithContext(ctx context.Context, end endpoint, result interface{}, opts ...Option) error {
+	_, err := c.postWithResponse(ctx, end, result, opts...)
+	return err
+}
+
+// postWithResponse sends a POST request to the provided endpoint with the
+// provided options, stores the results in the value pointed to by result, and
+// returns the metadata of the response. The request is canceled if the provided
+// context is canceled or its deadline is exceeded.
+func (c *Client) postWithResponse(ctx context.Context, end endpoint, result interface{}, opts ...Option) (*Response, error) {
 	req, err := c.request(end, opts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = c.send(req, result)
+	ctx, cancel := withTimeout(ctx, opts)
+	defer cancel()
+
+	var send sendFunc = c.sendWithResponse
+	switch {
+	case c.cache != nil && !skipsCache(opts):
+		send = c.sendCached
+	case c.coalesce:
+		send = c.sendCoalesced
+	}
+
+	resp, err := c.recorded(send)(req.WithContext(ctx), result)
+	if err == ErrNoResults && allowsEmpty(opts) {
+		setEmpty(result)
+		return resp, nil
+	}
 	if err != nil {
-		return errors.Wrap(err, "cannot make POST request")
+		if ctx.Err() != nil {
+			return resp, errors.Wrapf(ctx.Err(), "cannot make POST request to '%s' endpoint", end)
+		}
+		return resp, errors.Wrap(err, "cannot make POST request")
 	}
 
-	return nil
+	return resp, nil
 }
@@ -1,11 +1,16 @@
 package igdb
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -27,7 +32,7 @@ func TestClient_Request(t *testing.T) {
 			c := NewClient("YOUR_CLIENT_ID", "YOUR_APP_ACCESS_TOKEN", nil)
 
 			req, err := c.request(test.end, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -87,7 +92,7 @@ func TestClient_Send(t *testing.T) {
 			res := testResultPlaceholder{}
 
 			err = c.send(req, &res)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -98,6 +103,99 @@ func TestClient_Send(t *testing.T) {
 	}
 }
 
+func TestDecodeResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantRes []testResultPlaceholder
+		wantErr error
+	}{
+		{"Populated array", "[" + testResult + "]", []testResultPlaceholder{{SomeField: "some_value"}}, nil},
+		{"Populated array with surrounding whitespace", "\n [" + testResult + "] \n", []testResultPlaceholder{{SomeField: "some_value"}}, nil},
+		{"Empty array", "[]", nil, ErrNoResults},
+		{"Empty array with whitespace", " [ \n ] \n", nil, ErrNoResults},
+		{"Empty array with trailing data", "[]]", nil, errInvalidJSON},
+		{"Empty body", "", nil, errInvalidJSON},
+		{"Whitespace body", " \n", nil, errInvalidJSON},
+		{"Single open bracket", "[", nil, errInvalidJSON},
+		{"Single closed bracket", "]", nil, errInvalidJSON},
+		{"Double empty array", "[][]", nil, errInvalidJSON},
+		{"Malformed array", `[{"some_field": "some_value"},`, nil, errInvalidJSON},
+		{"Array missing comma", `[{"some_field": "some_value"} {"some_field": "some_value"}]`, nil, errInvalidJSON},
+		{"Array of wrong type", `[1, 2]`, nil, errInvalidJSON},
+		{"Populated array with trailing data", "[" + testResult + "]x", nil, errInvalidJSON},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res []testResultPlaceholder
+
+			err := decodeResults(strings.NewReader(test.body), &res)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(res, test.wantRes) {
+				t.Errorf("got: <%v>, want: <%v>", res, test.wantRes)
+			}
+		})
+	}
+}
+
+// BenchmarkDecodeResults compares buffering the entire body before decoding
+// it with decoding the body as it is read, using a large list of Games.
+func BenchmarkDecodeResults(b *testing.B) {
+	f, err := ioutil.ReadFile(testGameList)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var games []json.RawMessage
+	if err := json.Unmarshal(f, &games); err != nil {
+		b.Fatal(err)
+	}
+
+	var large []json.RawMessage
+	for len(large) < 5000 {
+		large = append(large, games...)
+	}
+
+	body, err := json.Marshal(large)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Buffered", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			buf, err := ioutil.ReadAll(bytes.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			var g []*Game
+			if err := json.Unmarshal(buf, &g); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Streamed", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			var g []*Game
+			if err := decodeResults(bytes.NewReader(body), &g); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 func TestClient_Post(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -596,7 +694,7 @@ func TestClient_Post(t *testing.T) {
 			res := testResultPlaceholder{}
 
 			err := c.post(testEndpoint, &res, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -606,3 +704,178 @@ func TestClient_Post(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetRaw(t *testing.T) {
+	const qry = "fields name,rating; where rating > 80 & platforms = (48); sort rating desc; limit 50;"
+
+	tests := []struct {
+		name    string
+		status  int
+		resp    string
+		qry     string
+		wantRes testResultPlaceholder
+		wantErr error
+	}{
+		{"Valid query", http.StatusOK, testResult, qry, testResultPlaceholder{SomeField: "some_value"}, nil},
+		{"Empty query", http.StatusOK, testResult, "", testResultPlaceholder{}, ErrEmptyQry},
+		{"Blank query", http.StatusOK, testResult, "   ", testResultPlaceholder{}, ErrEmptyQry},
+		{"No results", http.StatusOK, "[]", qry, testResultPlaceholder{}, ErrNoResults},
+		{"Bad status", http.StatusBadRequest, "", qry, testResultPlaceholder{}, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+				}
+				body = string(b)
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			res := testResultPlaceholder{}
+
+			err := c.GetRaw(testEndpoint, test.qry, &res)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.wantRes) {
+				t.Errorf("got: <%v>, want: <%v>", res, test.wantRes)
+			}
+
+			if test.wantErr == ErrEmptyQry {
+				return
+			}
+
+			if body != test.qry {
+				t.Errorf("got body: <%v>, want: <%v>", body, test.qry)
+			}
+		})
+	}
+}
+
+func TestClient_PostWithContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		delay   time.Duration
+		wantRes testResultPlaceholder
+		wantErr error
+	}{
+		{
+			"Background context",
+			func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			0,
+			testResultPlaceholder{SomeField: "some_value"},
+			nil,
+		},
+		{
+			"Canceled context",
+			func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			0,
+			testResultPlaceholder{},
+			context.Canceled,
+		},
+		{
+			"Deadline exceeded mid-request",
+			func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			time.Second,
+			testResultPlaceholder{},
+			context.DeadlineExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(test.delay):
+				case <-r.Context().Done():
+					return
+				}
+				w.Write([]byte(testResult))
+			})
+			defer ts.Close()
+
+			ctx, cancel := test.ctx()
+			defer cancel()
+
+			res := testResultPlaceholder{}
+
+			err := c.postWithContext(ctx, testEndpoint, &res)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), string(testEndpoint)) {
+				t.Errorf("got: <%v>, want error mentioning endpoint <%v>", err, testEndpoint)
+			}
+
+			if !reflect.DeepEqual(res, test.wantRes) {
+				t.Errorf("got: <%v>, want: <%v>", res, test.wantRes)
+			}
+		})
+	}
+}
+
+// TestClient_ServicesWithContext checks that the WithContext methods of every
+// service on the Client stop at a canceled context.
+func TestClient_ServicesWithContext(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, testResult)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	args := map[reflect.Type]reflect.Value{
+		reflect.TypeOf((*context.Context)(nil)).Elem(): reflect.ValueOf(ctx),
+		reflect.TypeOf(0):       reflect.ValueOf(1),
+		reflect.TypeOf([]int{}): reflect.ValueOf([]int{1}),
+		reflect.TypeOf(""):      reflect.ValueOf("mario"),
+	}
+
+	cv := reflect.ValueOf(c).Elem()
+	for i := 0; i < cv.NumField(); i++ {
+		svc := cv.Field(i)
+		if cv.Type().Field(i).PkgPath != "" || !strings.HasSuffix(svc.Type().String(), "Service") {
+			continue
+		}
+
+		for j := 0; j < svc.NumMethod(); j++ {
+			m := svc.Type().Method(j)
+			if !strings.HasSuffix(m.Name, "WithContext") {
+				continue
+			}
+
+			t.Run(cv.Type().Field(i).Name+"."+m.Name, func(t *testing.T) {
+				var in []reflect.Value
+				for k := 1; k < m.Type.NumIn(); k++ {
+					if m.Type.IsVariadic() && k == m.Type.NumIn()-1 {
+						break
+					}
+					arg, ok := args[m.Type.In(k)]
+					if !ok {
+						t.Fatalf("no test argument for parameter of type %v", m.Type.In(k))
+					}
+					in = append(in, arg)
+				}
+
+				out := svc.Method(j).Call(in)
+				err, _ := out[len(out)-1].Interface().(error)
+				if !errors.Is(err, context.Canceled) {
+					t.Errorf("got: <%v>, want: <%v>", err, context.Canceled)
+				}
+			})
+		}
+	}
+}
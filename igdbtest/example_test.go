@@ -0,0 +1,45 @@
+package igdbtest_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AdamHebby/igdb/v2"
+	"github.com/AdamHebby/igdb/v2/igdbtest"
+)
+
+func ExampleNewFixtureClient() {
+	c, teardown := igdbtest.NewFixtureClient(map[string]string{
+		string(igdb.EndpointGame): `[{"id": 7346, "name": "The Legend of Zelda: Breath of the Wild"}]`,
+	})
+	defer teardown()
+
+	g, err := c.Games.Get(7346)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(g.Name)
+	// Output: The Legend of Zelda: Breath of the Wild
+}
+
+func ExampleServer() {
+	s := igdbtest.NewServer(map[string]string{
+		string(igdb.EndpointGame): `[{"id": 7346, "name": "The Legend of Zelda: Breath of the Wild"}]`,
+	})
+	defer s.Close()
+
+	c := s.Client()
+	c.Games.Search("zelda", igdb.SetFields("name"))
+
+	fmt.Println(strings.TrimSpace(s.LastQuery(string(igdb.EndpointGame))))
+
+	s.Handle(string(igdb.EndpointGame), igdbtest.NoResults())
+	_, err := c.Games.Search("zelda")
+
+	fmt.Println(err != nil)
+	// Output:
+	// search "zelda"; fields name;
+	// true
+}
@@ -0,0 +1,233 @@
+// Package igdbtest provides utilities for testing code that communicates
+// with the IGDB through the igdb package without making real API calls.
+package igdbtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Call represents a single request made through a MockTransport.
+type Call struct {
+	// Path is the URL path of the request (e.g. /v4/games/).
+	Path string
+	// Query is the apicalypse query sent in the request body.
+	Query string
+}
+
+// CallRecorder is implemented by the types that record the requests made by a
+// Client, such as MockTransport and Server, so their calls can be asserted on.
+type CallRecorder interface {
+	// Calls returns every recorded Call made to the provided endpoint.
+	Calls(endpoint string) []Call
+}
+
+// MockTransport is an http.RoundTripper that records every request made
+// through it and responds with a canned response instead of contacting
+// the IGDB.
+type MockTransport struct {
+	// Status is the HTTP status code returned for every request.
+	Status int
+	// Body is the response body returned for every request.
+	Body string
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewMockTransport returns a MockTransport that responds to every request
+// with the provided status code and body.
+func NewMockTransport(status int, body string) *MockTransport {
+	return &MockTransport{Status: status, Body: body}
+}
+
+// Client returns an HTTP Client that sends its requests through this
+// MockTransport. Pass it to igdb.NewClient to record the client's requests.
+func (mt *MockTransport) Client() *http.Client {
+	return &http.Client{Transport: mt}
+}
+
+// RoundTrip records the provided request and returns the canned response.
+// RoundTrip fulfills the http.RoundTripper interface.
+func (mt *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var qry string
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		qry = string(b)
+	}
+
+	mt.mu.Lock()
+	mt.calls = append(mt.calls, Call{Path: req.URL.Path, Query: qry})
+	mt.mu.Unlock()
+
+	return &http.Response{
+		Status:     http.StatusText(mt.Status),
+		StatusCode: mt.Status,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(mt.Body)),
+		Request:    req,
+	}, nil
+}
+
+// Calls returns every recorded Call made to the provided endpoint (e.g.
+// "games/" or "games/count"), in the order they were made.
+func (mt *MockTransport) Calls(endpoint string) []Call {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+
+	var calls []Call
+	for _, c := range mt.calls {
+		if matchesEndpoint(c.Path, endpoint) {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset discards every recorded Call.
+func (mt *MockTransport) Reset() {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+
+	mt.calls = nil
+}
+
+// AssertEndpointCalled fails the test if the provided endpoint was never
+// called with the expected apicalypse query. Queries are compared clause by
+// clause so the order in which the clauses were written does not matter.
+func AssertEndpointCalled(t *testing.T, mt CallRecorder, endpoint string, expectedQuery string) {
+	t.Helper()
+
+	calls := mt.Calls(endpoint)
+	if len(calls) <= 0 {
+		t.Errorf("endpoint %q was never called", endpoint)
+		return
+	}
+
+	if !calledWith(calls, expectedQuery) {
+		t.Errorf("endpoint %q was never called with query <%s>, got: %v", endpoint, expectedQuery, queries(calls))
+	}
+}
+
+// AssertEndpointCalledMatch fails the test if the provided endpoint was never
+// called with an apicalypse query matching the provided regular expression.
+// Note that the order of the clauses within a query is not guaranteed, so
+// patterns should only target individual clauses.
+func AssertEndpointCalledMatch(t *testing.T, mt CallRecorder, endpoint string, pattern string) {
+	t.Helper()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("invalid query pattern %q: %v", pattern, err)
+	}
+
+	calls := mt.Calls(endpoint)
+	if len(calls) <= 0 {
+		t.Errorf("endpoint %q was never called", endpoint)
+		return
+	}
+
+	if !calledMatching(calls, re) {
+		t.Errorf("endpoint %q was never called with a query matching <%s>, got: %v", endpoint, pattern, queries(calls))
+	}
+}
+
+// AssertCallCount fails the test if the provided endpoint was not called
+// exactly expectedN times.
+func AssertCallCount(t *testing.T, mt CallRecorder, endpoint string, expectedN int) {
+	t.Helper()
+
+	if n := len(mt.Calls(endpoint)); n != expectedN {
+		t.Errorf("endpoint %q called %d times, want %d", endpoint, n, expectedN)
+	}
+}
+
+// AssertCallCountMatch fails the test if the provided endpoint was not called
+// exactly expectedN times with an apicalypse query matching the provided
+// regular expression.
+func AssertCallCountMatch(t *testing.T, mt CallRecorder, endpoint string, pattern string, expectedN int) {
+	t.Helper()
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		t.Fatalf("invalid query pattern %q: %v", pattern, err)
+	}
+
+	var n int
+	for _, c := range mt.Calls(endpoint) {
+		if re.MatchString(c.Query) {
+			n++
+		}
+	}
+
+	if n != expectedN {
+		t.Errorf("endpoint %q called %d times with a query matching <%s>, want %d", endpoint, n, pattern, expectedN)
+	}
+}
+
+// matchesEndpoint returns true if the provided URL path addresses the
+// provided endpoint.
+func matchesEndpoint(path, endpoint string) bool {
+	endpoint = strings.TrimPrefix(endpoint, "/")
+	return path == "/"+endpoint || strings.HasSuffix(path, "/"+endpoint)
+}
+
+// calledWith returns true if any of the provided calls sent a query
+// equivalent to the provided query.
+func calledWith(calls []Call, qry string) bool {
+	want := normalizeQuery(qry)
+	for _, c := range calls {
+		if normalizeQuery(c.Query) == want {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calledMatching returns true if any of the provided calls sent a query
+// matching the provided regular expression.
+func calledMatching(calls []Call, re *regexp.Regexp) bool {
+	for _, c := range calls {
+		if re.MatchString(c.Query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeQuery returns the provided apicalypse query with its clauses
+// trimmed and sorted so that equivalent queries can be compared directly.
+func normalizeQuery(qry string) string {
+	var clauses []string
+	for _, c := range strings.Split(qry, ";") {
+		c = strings.Join(strings.Fields(c), " ")
+		if c != "" {
+			clauses = append(clauses, c)
+		}
+	}
+
+	sort.Strings(clauses)
+	return strings.Join(clauses, "; ")
+}
+
+// queries returns the queries sent by the provided calls.
+func queries(calls []Call) []string {
+	q := make([]string, len(calls))
+	for i, c := range calls {
+		q[i] = c.Query
+	}
+
+	return q
+}
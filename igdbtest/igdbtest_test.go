@@ -0,0 +1,114 @@
+package igdbtest
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/AdamHebby/igdb/v2"
+)
+
+const testGames = `[{"id": 1, "name": "Halo"}]`
+
+func TestMockTransport(t *testing.T) {
+	mt := NewMockTransport(http.StatusOK, testGames)
+	c := igdb.NewClient("notarealclientid", "notarealtoken", mt.Client())
+
+	g, err := c.Games.Index(igdb.SetLimit(5), igdb.SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g) != 1 || g[0].Name != "Halo" {
+		t.Errorf("got: <%v>, want: <%v>", g, testGames)
+	}
+
+	if _, err = c.Games.Get(1); err != nil {
+		t.Fatal(err)
+	}
+
+	AssertCallCount(t, mt, "games/", 2)
+	AssertCallCount(t, mt, "covers/", 0)
+	AssertEndpointCalled(t, mt, "games/", "fields name; limit 5;")
+	AssertEndpointCalled(t, mt, "games/", "where id = 1;")
+	AssertEndpointCalledMatch(t, mt, "games/", `limit \d+`)
+	AssertCallCountMatch(t, mt, "games/", `where id = \d+`, 1)
+
+	mt.Reset()
+	AssertCallCount(t, mt, "games/", 0)
+}
+
+func TestMatchesEndpoint(t *testing.T) {
+	var tests = []struct {
+		name     string
+		path     string
+		endpoint string
+		want     bool
+	}{
+		{"Exact endpoint", "/v4/games/", "games/", true},
+		{"Leading slash", "/v4/games/", "/games/", true},
+		{"Count endpoint", "/v4/games/count", "games/count", true},
+		{"Count is not index", "/v4/games/count", "games/", false},
+		{"Partial name", "/v4/age_ratings/", "ratings/", false},
+		{"Root path", "/games/", "games/", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := matchesEndpoint(test.path, test.endpoint); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCalledWith(t *testing.T) {
+	calls := []Call{
+		{Path: "/v4/games/", Query: "limit 5; fields name,rating; "},
+		{Path: "/v4/games/", Query: "where id = 1; "},
+	}
+
+	var tests = []struct {
+		name string
+		qry  string
+		want bool
+	}{
+		{"Same order", "limit 5; fields name,rating;", true},
+		{"Different order", "fields name,rating; limit 5", true},
+		{"Extra whitespace", "  where   id = 1 ;", true},
+		{"Missing clause", "limit 5;", false},
+		{"Different value", "where id = 2;", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := calledWith(calls, test.qry); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCalledMatching(t *testing.T) {
+	calls := []Call{
+		{Path: "/v4/games/", Query: "limit 5; where rating > 80; "},
+	}
+
+	var tests = []struct {
+		name    string
+		pattern string
+		want    bool
+	}{
+		{"Matching clause", `where rating > \d+`, true},
+		{"Matching limit", `limit [1-9]`, true},
+		{"Non-matching clause", `offset \d+`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := calledMatching(calls, regexp.MustCompile(test.pattern)); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package igdbtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AdamHebby/igdb/v2"
+)
+
+// Credentials used by the Clients returned by a Server.
+const (
+	testClientID = "igdbtest-client-id"
+	testToken    = "igdbtest-token"
+)
+
+// Response is a canned response returned by a Server.
+type Response struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Body is the body of the response.
+	Body string
+	// Header contains any additional headers of the response.
+	Header http.Header
+}
+
+// JSON returns a Response with an OK status and the provided JSON body.
+func JSON(body string) Response {
+	return Response{Status: http.StatusOK, Body: body}
+}
+
+// NoResults returns a Response with an OK status and an empty array body,
+// causing the Client to return igdb.ErrNoResults.
+func NoResults() Response {
+	return JSON("[]")
+}
+
+// MalformedJSON returns a Response with an OK status and a body that
+// cannot be decoded.
+func MalformedJSON() Response {
+	return JSON(`[{"id": 1,`)
+}
+
+// RateLimited returns a Response with a Too Many Requests status and a
+// Retry-After header set to the provided duration, rounded up to the
+// nearest second. A non-positive duration omits the header.
+func RateLimited(retryAfter time.Duration) Response {
+	r := Response{Status: http.StatusTooManyRequests, Header: make(http.Header)}
+	if retryAfter > 0 {
+		sec := int((retryAfter + time.Second - 1) / time.Second)
+		r.Header.Set("Retry-After", strconv.Itoa(sec))
+	}
+
+	return r
+}
+
+// ServerError returns a Response with an Internal Server Error status.
+func ServerError() Response {
+	return Response{Status: http.StatusInternalServerError}
+}
+
+// Server is a fake IGDB backed by canned responses for each endpoint. The
+// Server records every request it receives so tests can assert on the
+// apicalypse queries a Client sent. Requests to an endpoint without a
+// response respond with a Not Found status.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string]Response
+	calls     []Call
+}
+
+// NewServer returns a started Server responding to each of the provided
+// endpoints (e.g. "games/" or string(igdb.EndpointGame)) with an OK status
+// and the corresponding JSON body. Call Close when finished.
+func NewServer(fixtures map[string]string) *Server {
+	s := &Server{responses: make(map[string]Response)}
+	for end, body := range fixtures {
+		s.Handle(end, JSON(body))
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// NewFixtureClient returns a Client communicating with a new Server that
+// responds to each of the provided endpoints with the corresponding JSON
+// body, along with a function that closes the Server.
+func NewFixtureClient(fixtures map[string]string) (*igdb.Client, func()) {
+	s := NewServer(fixtures)
+	return s.Client(), s.Close
+}
+
+// Client returns a new Client that sends its requests to the Server.
+func (s *Server) Client() *igdb.Client {
+	c, err := igdb.New(testClientID, testToken,
+		igdb.WithRootURL(s.URL),
+		igdb.WithHTTPClient(s.Server.Client()),
+	)
+	if err != nil {
+		panic("igdbtest: cannot create client: " + err.Error())
+	}
+
+	return c
+}
+
+// Handle sets the Response returned for requests to the provided endpoint,
+// replacing any previous Response.
+func (s *Server) Handle(endpoint string, resp Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses[strings.TrimPrefix(endpoint, "/")] = resp
+}
+
+// Calls returns every recorded Call made to the provided endpoint (e.g.
+// "games/" or "games/count"), in the order they were made.
+func (s *Server) Calls(endpoint string) []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []Call
+	for _, c := range s.calls {
+		if matchesEndpoint(c.Path, endpoint) {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// LastQuery returns the apicalypse query of the most recent request made to
+// the provided endpoint. If the endpoint was never called, an empty string is
+// returned.
+func (s *Server) LastQuery(endpoint string) string {
+	calls := s.Calls(endpoint)
+	if len(calls) == 0 {
+		return ""
+	}
+
+	return calls[len(calls)-1].Query
+}
+
+// Reset discards every recorded Call.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+}
+
+// serveHTTP records the provided request and responds with the
+// Response set for its endpoint.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{Path: r.URL.Path, Query: string(b)})
+	resp, ok := s.responses[strings.TrimPrefix(r.URL.Path, "/")]
+	s.mu.Unlock()
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status": 404, "message": "igdbtest: no response for endpoint"}`))
+		return
+	}
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+
+	w.WriteHeader(resp.Status)
+	w.Write([]byte(resp.Body))
+}
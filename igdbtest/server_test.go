@@ -0,0 +1,151 @@
+package igdbtest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/AdamHebby/igdb/v2"
+	"github.com/pkg/errors"
+)
+
+func TestNewFixtureClient(t *testing.T) {
+	c, teardown := NewFixtureClient(map[string]string{
+		string(igdb.EndpointGame): testGames,
+	})
+	defer teardown()
+
+	g, err := c.Games.Index(igdb.SetLimit(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g) != 1 || g[0].Name != "Halo" {
+		t.Errorf("got: <%v>, want: <%v>", g, testGames)
+	}
+
+	var apiErr *igdb.APIError
+	if _, err := c.Covers.Index(); !errors.As(err, &apiErr) || apiErr.Status != http.StatusNotFound {
+		t.Errorf("got: <%v>, want Not Found error for endpoint without fixture", err)
+	}
+}
+
+func TestServer_Responses(t *testing.T) {
+	var tests = []struct {
+		name    string
+		resp    Response
+		wantErr error
+		check   func(t *testing.T, err error)
+	}{
+		{"Fixture", JSON(testGames), nil, nil},
+		{"No results", NoResults(), igdb.ErrNoResults, nil},
+		{"Server error", ServerError(), igdb.ErrInternalError, nil},
+		{
+			"Rate limited",
+			RateLimited(2 * time.Second),
+			igdb.ErrManyRequests,
+			func(t *testing.T, err error) {
+				var apiErr *igdb.APIError
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("got: <%T>, want: <%T>", err, apiErr)
+				}
+
+				if apiErr.RetryAfter != 2*time.Second {
+					t.Errorf("got: <%v>, want: <%v>", apiErr.RetryAfter, 2*time.Second)
+				}
+
+				if !igdb.IsRateLimited(err) {
+					t.Errorf("got: <false>, want: <true>")
+				}
+			},
+		},
+		{
+			"Malformed JSON",
+			MalformedJSON(),
+			nil,
+			func(t *testing.T, err error) {
+				if err == nil {
+					t.Errorf("got: <nil>, want: decoding error")
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewServer(nil)
+			defer s.Close()
+
+			s.Handle("games/", test.resp)
+
+			_, err := s.Client().Games.Index()
+			if test.check != nil {
+				test.check(t, err)
+				return
+			}
+
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_LastQuery(t *testing.T) {
+	s := NewServer(map[string]string{"games/": testGames, "/covers/": `[{"id": 1}]`})
+	defer s.Close()
+
+	c := s.Client()
+
+	if got := s.LastQuery("games/"); got != "" {
+		t.Errorf("got: <%v>, want: <>", got)
+	}
+
+	if _, err := c.Games.List([]int{1}, igdb.SetFields("name")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Games.Index(igdb.SetFields("name", "rating"), igdb.SetLimit(5)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Covers.Get(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := s.LastQuery("games/"), "fields name,rating; limit 5; "; got != want {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	AssertCallCount(t, s, "games/", 2)
+	AssertCallCount(t, s, "covers/", 1)
+	AssertEndpointCalled(t, s, "covers/", "where id = 1;")
+
+	s.Reset()
+	AssertCallCount(t, s, "games/", 0)
+}
+
+func TestRateLimited(t *testing.T) {
+	var tests = []struct {
+		name       string
+		retryAfter time.Duration
+		want       string
+	}{
+		{"Whole seconds", 3 * time.Second, "3"},
+		{"Rounded up", 1500 * time.Millisecond, "2"},
+		{"No header", 0, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RateLimited(test.retryAfter)
+			if r.Status != http.StatusTooManyRequests {
+				t.Errorf("got: <%v>, want: <%v>", r.Status, http.StatusTooManyRequests)
+			}
+
+			if got := r.Header.Get("Retry-After"); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package igdb
 
 import (
-	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
 	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
 )
 
 // Errors returned when creating Image URLs.
@@ -12,6 +18,8 @@ var (
 	ErrBlankID = errors.New("image id value empty")
 	// ErrPixelRatio occurs when an unsupported display pixel ratio is used as an argument in a function.
 	ErrPixelRatio = errors.New("invalid display pixel ratio")
+	// ErrImageDownload occurs when the IGDB images CDN responds to an image download with an unsuccessful status.
+	ErrImageDownload = errors.New("cannot download image")
 )
 
 //go:generate gomodifytags -file $GOFILE -struct Image -add-tags json -w
@@ -27,39 +35,45 @@ type Image struct {
 	Width        int    `json:"width"`
 }
 
-// imageSize is the size of an image from the IGDB API. Note that this is not
+// ImageSize is the size of an image from the IGDB API. Note that this is not
 // the precise size of an image, but rather the maximum possible size of
 // the referenced image.
-type imageSize string
+type ImageSize string
 
 // Available image sizes supported by the IGDB API
 const (
 	// SizeCoverSmall is sized at 90x128.
-	SizeCoverSmall imageSize = "cover_small"
+	SizeCoverSmall ImageSize = "cover_small"
 	// SizeCoverBig is sized at 227x320.
-	SizeCoverBig imageSize = "cover_big"
+	SizeCoverBig ImageSize = "cover_big"
 	// SizeScreenshotMed is sized at 569x320.
-	SizeScreenshotMed imageSize = "screenshot_med"
+	SizeScreenshotMed ImageSize = "screenshot_med"
 	// SizeScreenshotBig is sized at 889x500
-	SizeScreenshotBig imageSize = "screenshot_big"
+	SizeScreenshotBig ImageSize = "screenshot_big"
 	// SizeScreenshotHuge is sized at 1280x720.
-	SizeScreenshotHuge imageSize = "screenshot_huge"
+	SizeScreenshotHuge ImageSize = "screenshot_huge"
 	// SizeLogoMed is sized at 284x160.
-	SizeLogoMed imageSize = "logo_med"
+	SizeLogoMed ImageSize = "logo_med"
 	// SizeMicro is sized at 35x35.
-	SizeMicro imageSize = "micro"
+	SizeMicro ImageSize = "micro"
 	// SizeThumb is sized at 90x90.
-	SizeThumb imageSize = "thumb"
+	SizeThumb ImageSize = "thumb"
 	// Size720p is sized at 1280x720.
-	Size720p imageSize = "720p"
+	Size720p ImageSize = "720p"
 	// Size1080p is sized at 1920x1080.
-	Size1080p imageSize = "1080p"
+	Size1080p ImageSize = "1080p"
 )
 
 // SizedImageURL returns the URL of an image identified by the provided imageID,
 // image size, and display pixel ratio. The display pixel ratio only multiplies
 // the resolution of the image. The current available ratios are 1 and 2.
-func SizedImageURL(imageID string, size imageSize, ratio int) (string, error) {
+func SizedImageURL(imageID string, size ImageSize, ratio int) (string, error) {
+	return sizedImageURL(igdbImageURL, imageID, size, ratio)
+}
+
+// sizedImageURL returns the URL of an image identified by the provided imageID,
+// image size, and display pixel ratio relative to the provided root URL.
+func sizedImageURL(root string, imageID string, size ImageSize, ratio int) (string, error) {
 	if blank.Is(imageID) {
 		return "", ErrBlankID
 	}
@@ -75,13 +89,81 @@ func SizedImageURL(imageID string, size imageSize, ratio int) (string, error) {
 		return "", ErrPixelRatio
 	}
 
-	url := fmt.Sprintf("https://images.igdb.com/igdb/image/upload/t_%s%s/%s.jpg", size, dpr, imageID)
+	url := fmt.Sprintf("%st_%s%s/%s.jpg", root, size, dpr, imageID)
 	return url, nil
 }
 
 // SizedURL returns the URL of this image at the provided image size
 // and display pixel ratio. The display pixel ratio only multiplies
 // the resolution of the image. The current available ratios are 1 and 2.
-func (i Image) SizedURL(size imageSize, ratio int) (string, error) {
+func (i Image) SizedURL(size ImageSize, ratio int) (string, error) {
 	return SizedImageURL(i.ImageID, size, ratio)
 }
+
+// DownloadImage retrieves the image identified by the provided imageID at the
+// provided image size from the IGDB images CDN using the Client's HTTP client.
+// The caller is responsible for closing the returned image data. If the
+// Client is configured to retry, failed downloads are retried as well. If the
+// CDN responds with an unsuccessful status, an error is returned.
+func (c *Client) DownloadImage(imageID string, size ImageSize) (io.ReadCloser, error) {
+	url, err := sizedImageURL(c.imageURL, imageID, size, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot make request for image %s with size %s", imageID, size)
+	}
+
+	resp, err := c.withRetry(req, c.http.Do)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot download image %s with size %s", imageID, size)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		discard(resp)
+		return nil, errors.Wrapf(ErrImageDownload, "image %s with size %s responded with status %d", imageID, size, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// SaveImage retrieves the image identified by the provided imageID at the
+// provided image size from the IGDB images CDN and writes it to the file at
+// the provided path. The image is first written to a temporary file in the
+// same directory, then renamed, so the file at the path is never left
+// partially written. The saved file has the permissions 0644.
+func (c *Client) SaveImage(imageID string, size ImageSize, path string) error {
+	img, err := c.DownloadImage(imageID, size)
+	if err != nil {
+		return err
+	}
+	defer img.Close()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Wrapf(err, "cannot create temporary file for image %s", imageID)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, img); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "cannot write image %s", imageID)
+	}
+
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "cannot write image %s", imageID)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "cannot write image %s", imageID)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrapf(err, "cannot save image %s to %s", imageID, path)
+	}
+
+	return nil
+}
@@ -2,7 +2,12 @@ package igdb
 
 import (
 	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 // Mocked image arguments for testing.
@@ -16,7 +21,7 @@ func TestSizedImageURL(t *testing.T) {
 	var tests = []struct {
 		name    string
 		id      string
-		size    imageSize
+		size    ImageSize
 		ratio   int
 		wantURL string
 		wantErr error
@@ -32,7 +37,7 @@ func TestSizedImageURL(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			url, err := SizedImageURL(test.id, test.size, test.ratio)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -47,7 +52,7 @@ func TestImage_SizedURL(t *testing.T) {
 	var tests = []struct {
 		name    string
 		image   Image
-		size    imageSize
+		size    ImageSize
 		ratio   int
 		wantURL string
 		wantErr error
@@ -63,7 +68,7 @@ func TestImage_SizedURL(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			url, err := test.image.SizedURL(test.size, test.ratio)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -73,3 +78,163 @@ func TestImage_SizedURL(t *testing.T) {
 		})
 	}
 }
+
+func TestImage_Embedded(t *testing.T) {
+	var tests = []struct {
+		name  string
+		sizer interface {
+			SizedURL(ImageSize, int) (string, error)
+		}
+	}{
+		{"Cover", Cover{Image: Image{ImageID: testImageID}}},
+		{"Screenshot", &Screenshot{Image: Image{ImageID: testImageID}}},
+		{"Artwork", Artwork{Image: Image{ImageID: testImageID}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			url, err := test.sizer.SizedURL(SizeScreenshotMed, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if url != testImageURL {
+				t.Errorf("got: <%v>, want: <%v>", url, testImageURL)
+			}
+		})
+	}
+}
+
+// testImageServer initializes and returns a test server that serves testImageData
+// for the testImageID at SizeCoverBig after the provided number of failures with
+// the provided status. testImageServer also returns a Client configured for the
+// test server.
+func testImageServer(t *testing.T, failures int, status int) (*Client, func()) {
+	var calls int
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= failures {
+			w.WriteHeader(status)
+			return
+		}
+
+		if r.Method != "GET" || r.URL.Path != "/t_cover_big/"+testImageID+".jpg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(testImageData))
+	})
+	c.imageURL = ts.URL + "/"
+
+	return c, ts.Close
+}
+
+const testImageData = "not really a jpeg"
+
+func TestClient_DownloadImage(t *testing.T) {
+	var tests = []struct {
+		name     string
+		id       string
+		size     ImageSize
+		failures int
+		status   int
+		retries  int
+		wantData string
+		wantErr  error
+	}{
+		{"Valid image", testImageID, SizeCoverBig, 0, 0, 0, testImageData, nil},
+		{"Blank ID", " ", SizeCoverBig, 0, 0, 0, "", ErrBlankID},
+		{"Missing image", "missing", SizeCoverBig, 0, 0, 0, "", ErrImageDownload},
+		{"Unavailable CDN", testImageID, SizeCoverBig, 1, http.StatusServiceUnavailable, 0, "", ErrImageDownload},
+		{"Retried unavailable CDN", testImageID, SizeCoverBig, 1, http.StatusServiceUnavailable, 2, testImageData, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, done := testImageServer(t, test.failures, test.status)
+			defer done()
+
+			c.SetRetry(test.retries, time.Millisecond)
+
+			img, err := c.DownloadImage(test.id, test.size)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+			defer img.Close()
+
+			b, err := ioutil.ReadAll(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.wantData {
+				t.Errorf("got: <%s>, want: <%s>", b, test.wantData)
+			}
+		})
+	}
+}
+
+func TestClient_SaveImage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		name     string
+		id       string
+		path     string
+		wantData string
+		wantErr  error
+	}{
+		{"Valid image", testImageID, filepath.Join(dir, "cover.jpg"), testImageData, nil},
+		{"Missing image", "missing", filepath.Join(dir, "missing.jpg"), "", ErrImageDownload},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, done := testImageServer(t, 0, 0)
+			defer done()
+
+			err := c.SaveImage(test.id, SizeCoverBig, test.path)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			b, err := ioutil.ReadFile(test.path)
+			if test.wantErr != nil {
+				if !os.IsNotExist(err) {
+					t.Errorf("got: <%v>, want no file at <%v>", err, test.path)
+				}
+				return
+			}
+
+			if string(b) != test.wantData {
+				t.Errorf("got: <%s>, want: <%s>", b, test.wantData)
+			}
+
+			fi, err := os.Stat(test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fi.Mode().Perm() != 0644 {
+				t.Errorf("got: <%v>, want: <%v>", fi.Mode().Perm(), os.FileMode(0644))
+			}
+		})
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 {
+		t.Errorf("got: <%v> files, want only the saved image", len(files))
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -12,15 +13,15 @@ import (
 // of a particular video game.
 // For more information visit: https://api-docs.igdb.com/#involved-company
 type InvolvedCompany struct {
-	ID         int  `json:"id"`
-	Company    int  `json:"company"`
-	CreatedAt  int  `json:"created_at"`
-	Developer  bool `json:"developer"`
-	Game       int  `json:"game"`
-	Porting    bool `json:"porting"`
-	Publisher  bool `json:"publisher"`
-	Supporting bool `json:"supporting"`
-	UpdatedAt  int  `json:"updated_at"`
+	ID         int       `json:"id"`
+	Company    int       `json:"company"`
+	CreatedAt  Timestamp `json:"created_at"`
+	Developer  bool      `json:"developer"`
+	Game       int       `json:"game"`
+	Porting    bool      `json:"porting"`
+	Publisher  bool      `json:"publisher"`
+	Supporting bool      `json:"supporting"`
+	UpdatedAt  Timestamp `json:"updated_at"`
 }
 
 // InvolvedCompanyService handles all the API calls for the IGDB InvolvedCompany endpoint.
@@ -30,14 +31,23 @@ type InvolvedCompanyService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any InvolvedCompanies, an error is returned.
 func (is *InvolvedCompanyService) Get(id int, opts ...Option) (*InvolvedCompany, error) {
+	return is.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single InvolvedCompany identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// InvolvedCompanies, an error is returned.
+func (is *InvolvedCompanyService) GetWithContext(ctx context.Context, id int, opts ...Option) (*InvolvedCompany, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var com []*InvolvedCompany
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := is.client.post(is.end, &com, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := is.client.postWithContext(ctx, is.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get InvolvedCompany with ID %v", id)
 	}
@@ -50,6 +60,16 @@ func (is *InvolvedCompanyService) Get(id int, opts ...Option) (*InvolvedCompany,
 // Any ID that does not match a InvolvedCompany is ignored. If none of the IDs
 // match a InvolvedCompany, an error is returned.
 func (is *InvolvedCompanyService) List(ids []int, opts ...Option) ([]*InvolvedCompany, error) {
+	return is.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of InvolvedCompanies identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// InvolvedCompany is ignored. If none of the IDs match a InvolvedCompany, an
+// error is returned.
+func (is *InvolvedCompanyService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*InvolvedCompany, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -63,7 +83,7 @@ func (is *InvolvedCompanyService) List(ids []int, opts ...Option) ([]*InvolvedCo
 	var com []*InvolvedCompany
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := is.client.post(is.end, &com, opts...)
+	err := is.client.postWithContext(ctx, is.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get InvolvedCompanies with IDs %v", ids)
 	}
@@ -71,13 +91,95 @@ func (is *InvolvedCompanyService) List(ids []int, opts ...Option) ([]*InvolvedCo
 	return com, nil
 }
 
+// ListByGame returns the list of InvolvedCompanies belonging to the Game identified by
+// the provided IGDB ID. Up to 500 InvolvedCompanies are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no InvolvedCompanies, an error is returned.
+func (is *InvolvedCompanyService) ListByGame(gameID int, opts ...Option) ([]*InvolvedCompany, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var com []*InvolvedCompany
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := is.client.post(is.end, &com, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get InvolvedCompanies with Game ID %v", gameID)
+	}
+
+	return com, nil
+}
+
+// DevelopersOf returns the IGDB IDs of the Companies that developed the Game
+// identified by the provided IGDB ID. If the Game has no developers, an error
+// is returned.
+func (is *InvolvedCompanyService) DevelopersOf(gameID int) ([]int, error) {
+	ids, err := is.companiesOf(gameID, "developer")
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get developers of Game with ID %v", gameID)
+	}
+
+	return ids, nil
+}
+
+// PublishersOf returns the IGDB IDs of the Companies that published the Game
+// identified by the provided IGDB ID. If the Game has no publishers, an error
+// is returned.
+func (is *InvolvedCompanyService) PublishersOf(gameID int) ([]int, error) {
+	ids, err := is.companiesOf(gameID, "publisher")
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get publishers of Game with ID %v", gameID)
+	}
+
+	return ids, nil
+}
+
+// companiesOf returns the IGDB IDs of the Companies involved in the Game
+// identified by the provided IGDB ID whose provided boolean role is true.
+func (is *InvolvedCompanyService) companiesOf(gameID int, role string) ([]int, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var com []*InvolvedCompany
+
+	opts := []Option{
+		SetFields("company"),
+		SetLimit(maxLimit),
+		SetFilter("game", OpEquals, strconv.Itoa(gameID)),
+		SetFilter(role, OpEquals, "true"),
+	}
+	err := is.client.post(is.end, &com, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(com))
+	for _, c := range com {
+		ids = append(ids, c.Company)
+	}
+
+	return ids, nil
+}
+
 // Index returns an index of InvolvedCompanies based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no InvolvedCompanies can
 // be found using the provided options, an error is returned.
 func (is *InvolvedCompanyService) Index(opts ...Option) ([]*InvolvedCompany, error) {
+	return is.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of InvolvedCompanies based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no InvolvedCompanies can be found using the provided options,
+// an error is returned.
+func (is *InvolvedCompanyService) IndexWithContext(ctx context.Context, opts ...Option) ([]*InvolvedCompany, error) {
 	var com []*InvolvedCompany
 
-	err := is.client.post(is.end, &com, opts...)
+	err := is.client.postWithContext(ctx, is.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of InvolvedCompanies")
 	}
@@ -89,7 +191,15 @@ func (is *InvolvedCompanyService) Index(opts ...Option) ([]*InvolvedCompany, err
 // Provide the SetFilter functional option if you need to filter
 // which InvolvedCompanies to count.
 func (is *InvolvedCompanyService) Count(opts ...Option) (int, error) {
-	ct, err := is.client.getCount(is.end, opts...)
+	return is.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of InvolvedCompanies available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which InvolvedCompanies to count.
+func (is *InvolvedCompanyService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := is.client.getCountWithContext(ctx, is.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count InvolvedCompanies")
 	}
@@ -100,7 +210,14 @@ func (is *InvolvedCompanyService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB InvolvedCompany object.
 func (is *InvolvedCompanyService) Fields() ([]string, error) {
-	f, err := is.client.getFields(is.end)
+	return is.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// InvolvedCompany object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (is *InvolvedCompanyService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := is.client.getFieldsWithContext(ctx, is.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get InvolvedCompany fields")
 	}
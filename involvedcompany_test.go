@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestInvolvedCompanyService_Get(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.InvolvedCompanies.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestInvolvedCompanyService_List(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.InvolvedCompanies.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +108,154 @@ func TestInvolvedCompanyService_List(t *testing.T) {
 	}
 }
 
+func TestInvolvedCompanyService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testInvolvedCompanyList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*InvolvedCompany, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                  string
+		file                  string
+		id                    int
+		opts                  []Option
+		wantLim               string
+		wantInvolvedCompanies []*InvolvedCompany
+		wantErr               error
+	}{
+		{"Valid response", testInvolvedCompanyList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testInvolvedCompanyList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			com, err := c.InvolvedCompanies.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(com, test.wantInvolvedCompanies) {
+				t.Errorf("got: <%v>, \nwant: <%v>", com, test.wantInvolvedCompanies)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
+func TestInvolvedCompanyService_DevelopersOf(t *testing.T) {
+	var tests = []struct {
+		name     string
+		resp     string
+		id       int
+		wantBody string
+		wantIDs  []int
+		wantErr  error
+	}{
+		{"Valid response", `[{"id": 1, "company": 70}, {"id": 2, "company": 213}]`, 1942, "developer = true", []int{70, 213}, nil},
+		{"Invalid ID", "", -1, "", nil, ErrNegativeID},
+		{"Empty response", "", 1942, "developer = true", nil, errInvalidJSON},
+		{"No results", "[]", 1942, "developer = true", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			ids, err := c.InvolvedCompanies.DevelopersOf(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ids, test.wantIDs)
+			}
+
+			if !strings.Contains(body, test.wantBody) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantBody)
+			}
+		})
+	}
+}
+
+func TestInvolvedCompanyService_PublishersOf(t *testing.T) {
+	var tests = []struct {
+		name     string
+		resp     string
+		id       int
+		wantBody string
+		wantIDs  []int
+		wantErr  error
+	}{
+		{"Valid response", `[{"id": 2, "company": 213}]`, 1942, "publisher = true", []int{213}, nil},
+		{"Invalid ID", "", -1, "", nil, ErrNegativeID},
+		{"Empty response", "", 1942, "publisher = true", nil, errInvalidJSON},
+		{"No results", "[]", 1942, "publisher = true", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			ids, err := c.InvolvedCompanies.PublishersOf(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ids, test.wantIDs)
+			}
+
+			if !strings.Contains(body, test.wantBody) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantBody)
+			}
+		})
+	}
+}
+
 func TestInvolvedCompanyService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testInvolvedCompanyList)
 	if err != nil {
@@ -140,7 +289,7 @@ func TestInvolvedCompanyService_Index(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.InvolvedCompanies.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +320,7 @@ func TestInvolvedCompanyService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.InvolvedCompanies.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +351,7 @@ func TestInvolvedCompanyService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.InvolvedCompanies.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
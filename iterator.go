@@ -0,0 +1,128 @@
+package igdb
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// maxLimit is the maximum number of results the IGDB returns from a single API call.
+const maxLimit = 500
+
+// GameIterator iterates through the Games matching a set of functional options
+// by paging through the results with the maximum limit allowed by the IGDB.
+// Call Next to advance the iterator and Game to retrieve the current Game.
+type GameIterator struct {
+	gs        *GameService
+	opts      []Option
+	offset    int
+	remaining int // remaining is the number of Games left to retrieve, or -1 if unlimited.
+	page      []*Game
+	cur       *Game
+	done      bool
+	err       error
+}
+
+// Iterate returns a GameIterator over the Games matching the provided
+// functional options. The Games are retrieved lazily in pages of the maximum
+// limit allowed by the IGDB. The SetLimit functional option caps the total
+// number of Games retrieved rather than the size of each page and the
+// SetOffset functional option sets the position of the first Game. If no
+// Games are found, the iterator is empty rather than returning an error.
+func (gs *GameService) Iterate(opts ...Option) *GameIterator {
+	it := &GameIterator{
+		gs:        gs,
+		opts:      opts,
+		remaining: -1,
+	}
+
+	for _, opt := range opts {
+		clauses := optionClauses(opt)
+		if lim, err := strconv.Atoi(clauses["limit"]); err == nil {
+			it.remaining = lim
+		}
+		if off, err := strconv.Atoi(clauses["offset"]); err == nil {
+			it.offset = off
+		}
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next Game, retrieving the next page of
+// Games when necessary. Next returns false when there are no more Games or
+// an error occurs. Check Err to distinguish between the two.
+func (it *GameIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.done || it.remaining == 0 {
+			return false
+		}
+
+		it.fetch()
+		if len(it.page) == 0 {
+			return false
+		}
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Game returns the current Game of the iterator.
+func (it *GameIterator) Game() *Game {
+	return it.cur
+}
+
+// Err returns the first error encountered by the iterator, if any.
+func (it *GameIterator) Err() error {
+	return it.err
+}
+
+// ForEach calls the provided function with each remaining Game of the
+// iterator. ForEach stops at the first error returned by the function and
+// returns it. Otherwise, the error encountered by the iterator is returned.
+func (it *GameIterator) ForEach(fn func(*Game) error) error {
+	for it.Next() {
+		if err := fn(it.Game()); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
+
+// fetch retrieves the next page of Games and advances the offset.
+func (it *GameIterator) fetch() {
+	lim := maxLimit
+	if it.remaining >= 0 && it.remaining < lim {
+		lim = it.remaining
+	}
+
+	opts := withoutClauses(it.opts, "limit", "offset", clauseAllowEmpty)
+	opts = append(opts, SetLimit(lim), SetOffset(it.offset))
+
+	g, err := it.gs.Index(opts...)
+	if err != nil {
+		if errors.Cause(err) == ErrNoResults {
+			it.done = true
+			return
+		}
+
+		it.err = err
+		return
+	}
+
+	it.page = g
+	it.offset += len(g)
+	if it.remaining >= 0 {
+		it.remaining -= len(g)
+	}
+
+	if len(g) < lim {
+		it.done = true
+	}
+}
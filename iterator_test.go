@@ -0,0 +1,147 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	testLimitRegexp  = regexp.MustCompile(`limit (\d+);`)
+	testOffsetRegexp = regexp.MustCompile(`offset (\d+);`)
+)
+
+// testGameServer initializes and returns a test server that pages through
+// the provided number of Games with IDs starting at 1. testGameServer also
+// returns a Client configured for the test server and a pointer to the
+// number of requests made.
+func testGameServer(t *testing.T, total int, status int) (*httptest.Server, *Client, *int) {
+	var calls int
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lim, off := 10, 0
+		if m := testLimitRegexp.FindSubmatch(b); m != nil {
+			lim, _ = strconv.Atoi(string(m[1]))
+		}
+		if m := testOffsetRegexp.FindSubmatch(b); m != nil {
+			off, _ = strconv.Atoi(string(m[1]))
+		}
+
+		g := make([]*Game, 0)
+		for id := off + 1; id <= total && id <= off+lim; id++ {
+			g = append(g, &Game{ID: id})
+		}
+		json.NewEncoder(w).Encode(g)
+	})
+
+	return ts, c, &calls
+}
+
+func TestGameIterator(t *testing.T) {
+	ids := func(from, to int) []int {
+		var s []int
+		for i := from; i <= to; i++ {
+			s = append(s, i)
+		}
+		return s
+	}
+
+	var tests = []struct {
+		name      string
+		total     int
+		status    int
+		opts      []Option
+		wantIDs   []int
+		wantCalls int
+		wantErr   error
+	}{
+		{"Single page", 42, http.StatusOK, nil, ids(1, 42), 1, nil},
+		{"Multiple pages", 1234, http.StatusOK, nil, ids(1, 1234), 3, nil},
+		{"Exact page multiple", 1000, http.StatusOK, nil, ids(1, 1000), 3, nil},
+		{"Total cap", 1234, http.StatusOK, []Option{SetLimit(120)}, ids(1, 120), 1, nil},
+		{"Starting offset", 1234, http.StatusOK, []Option{SetOffset(1000)}, ids(1001, 1234), 1, nil},
+		{"Starting offset with cap", 1234, http.StatusOK, []Option{ComposeOptions(SetOffset(600), SetLimit(5))}, ids(601, 605), 1, nil},
+		{"No results", 0, http.StatusOK, nil, nil, 1, nil},
+		{"Invalid option", 10, http.StatusOK, []Option{SetFields("")}, nil, 0, ErrEmptyFields},
+		{"Bad status", 10, http.StatusBadRequest, nil, nil, 1, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, calls := testGameServer(t, test.total, test.status)
+			defer ts.Close()
+
+			var got []int
+			it := c.Games.Iterate(test.opts...)
+			for it.Next() {
+				got = append(got, it.Game().ID)
+			}
+
+			if !errors.Is(it.Err(), test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(it.Err()), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, test.wantIDs) {
+				t.Errorf("got: <%v> Games, want: <%v> Games", len(got), len(test.wantIDs))
+			}
+
+			if *calls != test.wantCalls {
+				t.Errorf("got: <%v> calls, want: <%v>", *calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGameIterator_ForEach(t *testing.T) {
+	errStop := errors.New("stop")
+
+	var tests = []struct {
+		name      string
+		stopAt    int
+		wantCount int
+		wantErr   error
+	}{
+		{"All Games", 0, 700, nil},
+		{"Callback error", 600, 600, errStop},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, _ := testGameServer(t, 700, http.StatusOK)
+			defer ts.Close()
+
+			var count int
+			err := c.Games.Iterate().ForEach(func(g *Game) error {
+				count++
+				if g.ID == test.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if err != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", err, test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Errorf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/Henry-Sarabia/sliceconv"
@@ -13,11 +14,12 @@ import (
 // such as "World War 2" or "Steampunk".
 // For more information visit: https://api-docs.igdb.com/#keyword
 type Keyword struct {
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	Url       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	Url       string    `json:"url"`
 }
 
 // KeywordService handles all the API calls for the IGDB Keyword endpoint.
@@ -27,14 +29,23 @@ type KeywordService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Keywords, an error is returned.
 func (ks *KeywordService) Get(id int, opts ...Option) (*Keyword, error) {
+	return ks.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Keyword identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Keywords, an error is
+// returned.
+func (ks *KeywordService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Keyword, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var key []*Keyword
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ks.client.post(ks.end, &key, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ks.client.postWithContext(ctx, ks.end, &key, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Keyword with ID %v", id)
 	}
@@ -47,6 +58,15 @@ func (ks *KeywordService) Get(id int, opts ...Option) (*Keyword, error) {
 // Any ID that does not match a Keyword is ignored. If none of the IDs
 // match a Keyword, an error is returned.
 func (ks *KeywordService) List(ids []int, opts ...Option) ([]*Keyword, error) {
+	return ks.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Keywords identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Keyword is ignored. If
+// none of the IDs match a Keyword, an error is returned.
+func (ks *KeywordService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Keyword, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -60,7 +80,7 @@ func (ks *KeywordService) List(ids []int, opts ...Option) ([]*Keyword, error) {
 	var key []*Keyword
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ks.client.post(ks.end, &key, opts...)
+	err := ks.client.postWithContext(ctx, ks.end, &key, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Keywords with IDs %v", ids)
 	}
@@ -72,9 +92,18 @@ func (ks *KeywordService) List(ids []int, opts ...Option) ([]*Keyword, error) {
 // options used to sort, filter, and paginate the results. If no Keywords can
 // be found using the provided options, an error is returned.
 func (ks *KeywordService) Index(opts ...Option) ([]*Keyword, error) {
+	return ks.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Keywords based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Keywords can be found using the provided options, an error is
+// returned.
+func (ks *KeywordService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Keyword, error) {
 	var key []*Keyword
 
-	err := ks.client.post(ks.end, &key, opts...)
+	err := ks.client.postWithContext(ctx, ks.end, &key, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Keywords")
 	}
@@ -82,11 +111,43 @@ func (ks *KeywordService) Index(opts ...Option) ([]*Keyword, error) {
 	return key, nil
 }
 
+// Search returns a list of Keywords found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Keywords are found using the provided query, an error is returned.
+func (ks *KeywordService) Search(qry string, opts ...Option) ([]*Keyword, error) {
+	return ks.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Keywords found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Keywords are found using the provided
+// query, an error is returned.
+func (ks *KeywordService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Keyword, error) {
+	var key []*Keyword
+
+	opts = append(opts, setSearch(qry))
+	err := ks.client.postWithContext(ctx, ks.end, &key, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Keyword with query %s", qry)
+	}
+
+	return key, nil
+}
+
 // Count returns the number of Keywords available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Keywords to count.
 func (ks *KeywordService) Count(opts ...Option) (int, error) {
-	ct, err := ks.client.getCount(ks.end, opts...)
+	return ks.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Keywords available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Keywords to count.
+func (ks *KeywordService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ks.client.getCountWithContext(ctx, ks.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Keywords")
 	}
@@ -97,7 +158,14 @@ func (ks *KeywordService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Keyword object.
 func (ks *KeywordService) Fields() ([]string, error) {
-	f, err := ks.client.getFields(ks.end)
+	return ks.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Keyword
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ks *KeywordService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ks.client.getFieldsWithContext(ctx, ks.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Keyword fields")
 	}
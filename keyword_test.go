@@ -6,12 +6,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
 const (
-	testKeywordGet  string = "test_data/keyword_get.json"
-	testKeywordList string = "test_data/keyword_list.json"
+	testKeywordGet    string = "test_data/keyword_get.json"
+	testKeywordList   string = "test_data/keyword_list.json"
+	testKeywordSearch string = "test_data/keyword_search.json"
 )
 
 func TestKeywordService_Get(t *testing.T) {
@@ -49,7 +51,7 @@ func TestKeywordService_Get(t *testing.T) {
 			defer ts.Close()
 
 			key, err := c.Keywords.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +98,7 @@ func TestKeywordService_List(t *testing.T) {
 			defer ts.Close()
 
 			key, err := c.Keywords.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +142,7 @@ func TestKeywordService_Index(t *testing.T) {
 			defer ts.Close()
 
 			key, err := c.Keywords.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -151,6 +153,68 @@ func TestKeywordService_Index(t *testing.T) {
 	}
 }
 
+func TestKeywordService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testKeywordSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Keyword, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name         string
+		file         string
+		qry          string
+		opts         []Option
+		wantSearch   string
+		wantKeywords []*Keyword
+		wantErr      error
+	}{
+		{"Valid response", testKeywordSearch, "open world", []Option{SetLimit(50)}, `search "open world";`, init, nil},
+		{"Escaped query", testKeywordSearch, `"open" world`, nil, `search "\"open\" world";`, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, "", nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "open world", nil, "", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "open world", []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, "", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			key, err := c.Keywords.Search(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(key, test.wantKeywords) {
+				t.Errorf("got: <%v>, \nwant: <%v>", key, test.wantKeywords)
+			}
+
+			if !strings.Contains(body, test.wantSearch) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantSearch)
+			}
+		})
+	}
+}
+
 func TestKeywordService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -171,7 +235,7 @@ func TestKeywordService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Keywords.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +266,7 @@ func TestKeywordService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Keywords.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -0,0 +1,120 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/Henry-Sarabia/apicalypse"
+)
+
+// Response headers containing the metadata of an API call.
+const (
+	headerTotalCount    = "X-Total-Count"
+	headerCount         = "X-Count"
+	headerExecutionTime = "X-Execution-Time"
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+)
+
+// QueryMetadata contains the metadata the IGDB reports for a query without
+// returning any of the matching results. Any value the IGDB does not report
+// is left as zero.
+type QueryMetadata struct {
+	// TotalCount is the number of objects available at the queried endpoint.
+	TotalCount int
+	// MatchingCount is the number of objects matching the query's filters.
+	MatchingCount int
+	// ExecutionTimeMs is the time the IGDB took to execute the query in milliseconds.
+	ExecutionTimeMs int
+}
+
+// newQueryMetadata returns the QueryMetadata found in the provided headers.
+func newQueryMetadata(h http.Header) *QueryMetadata {
+	return &QueryMetadata{
+		TotalCount:      headerInt(h, headerTotalCount),
+		MatchingCount:   headerInt(h, headerCount),
+		ExecutionTimeMs: headerInt(h, headerExecutionTime),
+	}
+}
+
+// Response contains the metadata of an HTTP response returned by the IGDB
+// alongside the results of an API call. Any count the IGDB does not report
+// is set to -1.
+type Response struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Count is the number of objects matching the query's filters.
+	Count int
+	// RateLimit is the number of requests allowed in the current rate limit window.
+	RateLimit int
+	// RateLimitRemaining is the number of requests remaining in the current rate limit window.
+	RateLimitRemaining int
+	// Header contains the raw headers of the response.
+	Header http.Header
+}
+
+// newResponse returns the Response metadata of the provided HTTP response.
+func newResponse(resp *http.Response) *Response {
+	return &Response{
+		Status:             resp.StatusCode,
+		Count:              headerIntDefault(resp.Header, headerCount, -1),
+		RateLimit:          headerIntDefault(resp.Header, headerRateLimit, -1),
+		RateLimitRemaining: headerIntDefault(resp.Header, headerRateRemaining, -1),
+		Header:             resp.Header,
+	}
+}
+
+// copy returns a copy of the Response with its own Header. A nil
+// Response returns nil.
+func (r *Response) copy() *Response {
+	if r == nil {
+		return nil
+	}
+
+	cp := *r
+	cp.Header = r.Header.Clone()
+	return &cp
+}
+
+// headerInt returns the integer value of the provided header key.
+// If the header is missing or malformed, zero is returned.
+func headerInt(h http.Header, key string) int {
+	return headerIntDefault(h, key, 0)
+}
+
+// headerIntDefault returns the integer value of the provided header key.
+// If the header is missing or malformed, the provided default is returned.
+func headerIntDefault(h http.Header, key string, def int) int {
+	n, err := strconv.Atoi(h.Get(key))
+	if err != nil {
+		return def
+	}
+
+	return n
+}
+
+// setLimitZero is a functional option used to request only the metadata of
+// an API call without any of its results.
+func setLimitZero() Option {
+	return func() (apicalypse.Option, error) {
+		return apicalypse.Limit(0), nil
+	}
+}
+
+// getMetadata sends a request with a limit of zero to the provided endpoint
+// using the provided options and returns the metadata found in the response
+// headers. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (c *Client) getMetadata(ctx context.Context, end endpoint, opts ...Option) (*QueryMetadata, error) {
+	opts = append(withoutClauses(opts, "limit", clauseAllowEmpty), setLimitZero(), AllowEmptyResults())
+
+	var res []struct{}
+
+	resp, err := c.postWithResponse(ctx, end, &res, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newQueryMetadata(resp.Header), nil
+}
@@ -0,0 +1,127 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestClient_GetMetadata(t *testing.T) {
+	var tests = []struct {
+		name     string
+		status   int
+		headers  []testHeader
+		opts     []Option
+		wantMeta *QueryMetadata
+		wantErr  error
+	}{
+		{
+			"All headers",
+			http.StatusOK,
+			[]testHeader{{headerTotalCount, "250000"}, {headerCount, "1234"}, {headerExecutionTime, "12"}},
+			[]Option{SetFilter("hypes", OpGreaterThan, "75")},
+			&QueryMetadata{TotalCount: 250000, MatchingCount: 1234, ExecutionTimeMs: 12},
+			nil,
+		},
+		{
+			"Partial headers",
+			http.StatusOK,
+			[]testHeader{{headerCount, "42"}},
+			nil,
+			&QueryMetadata{MatchingCount: 42},
+			nil,
+		},
+		{
+			"Malformed headers",
+			http.StatusOK,
+			[]testHeader{{headerCount, "many"}},
+			nil,
+			&QueryMetadata{},
+			nil,
+		},
+		{
+			"Invalid option",
+			http.StatusOK,
+			nil,
+			[]Option{SetOffset(-1)},
+			nil,
+			ErrOutOfRange,
+		},
+		{
+			"Bad status",
+			http.StatusBadRequest,
+			nil,
+			nil,
+			nil,
+			ErrBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, "[]", test.headers...)
+			defer ts.Close()
+
+			md, err := c.getMetadata(context.Background(), testEndpoint, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(md, test.wantMeta) {
+				t.Errorf("got: <%v>, want: <%v>", md, test.wantMeta)
+			}
+		})
+	}
+}
+
+func TestGameService_GetMetadata_CacheAndRecorder(t *testing.T) {
+	var calls int32
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set(headerCount, "42")
+		w.Write([]byte("[]"))
+	})
+	defer ts.Close()
+
+	rec := &testRecorder{}
+	c.SetRecorder(rec)
+	c.SetCache(NewLRUCache(10), time.Minute)
+
+	want := &QueryMetadata{MatchingCount: 42}
+	for i := 0; i < 2; i++ {
+		md, err := c.Games.GetMetadata(SetFilter("hypes", OpGreaterThan, "75"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(md, want) {
+			t.Errorf("got: <%v>, want: <%v>", md, want)
+		}
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("got: <%v> requests, want: <%v> requests", got, 1)
+	}
+
+	if len(rec.stats) != 2 || rec.stats[0].Cached || !rec.stats[1].Cached {
+		t.Errorf("got: <%+v>, want an uncached and a cached RequestStat", rec.stats)
+	}
+}
+
+func TestGameService_GetMetadataWithContext(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, "[]", testHeader{headerCount, "42"})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.Games.GetMetadataWithContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), context.Canceled)
+	}
+}
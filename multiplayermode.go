@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -32,14 +33,23 @@ type MultiplayerModeService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any MultiplayerModes, an error is returned.
 func (ms *MultiplayerModeService) Get(id int, opts ...Option) (*MultiplayerMode, error) {
+	return ms.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single MultiplayerMode identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// MultiplayerModes, an error is returned.
+func (ms *MultiplayerModeService) GetWithContext(ctx context.Context, id int, opts ...Option) (*MultiplayerMode, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var mode []*MultiplayerMode
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ms.client.post(ms.end, &mode, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ms.client.postWithContext(ctx, ms.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get MultiplayerMode with ID %v", id)
 	}
@@ -52,6 +62,15 @@ func (ms *MultiplayerModeService) Get(id int, opts ...Option) (*MultiplayerMode,
 // Any ID that does not match a MultiplayerMode is ignored. If none of the IDs
 // match a MultiplayerMode, an error is returned.
 func (ms *MultiplayerModeService) List(ids []int, opts ...Option) ([]*MultiplayerMode, error) {
+	return ms.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of MultiplayerModes identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a MultiplayerMode is
+// ignored. If none of the IDs match a MultiplayerMode, an error is returned.
+func (ms *MultiplayerModeService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*MultiplayerMode, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -65,7 +84,7 @@ func (ms *MultiplayerModeService) List(ids []int, opts ...Option) ([]*Multiplaye
 	var mode []*MultiplayerMode
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ms.client.post(ms.end, &mode, opts...)
+	err := ms.client.postWithContext(ctx, ms.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get MultiplayerModes with IDs %v", ids)
 	}
@@ -77,9 +96,18 @@ func (ms *MultiplayerModeService) List(ids []int, opts ...Option) ([]*Multiplaye
 // options used to sort, filter, and paginate the results. If no MultiplayerModes can
 // be found using the provided options, an error is returned.
 func (ms *MultiplayerModeService) Index(opts ...Option) ([]*MultiplayerMode, error) {
+	return ms.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of MultiplayerModes based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no MultiplayerModes can be found using the provided options,
+// an error is returned.
+func (ms *MultiplayerModeService) IndexWithContext(ctx context.Context, opts ...Option) ([]*MultiplayerMode, error) {
 	var mode []*MultiplayerMode
 
-	err := ms.client.post(ms.end, &mode, opts...)
+	err := ms.client.postWithContext(ctx, ms.end, &mode, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of MultiplayerModes")
 	}
@@ -91,7 +119,15 @@ func (ms *MultiplayerModeService) Index(opts ...Option) ([]*MultiplayerMode, err
 // Provide the SetFilter functional option if you need to filter
 // which MultiplayerModes to count.
 func (ms *MultiplayerModeService) Count(opts ...Option) (int, error) {
-	ct, err := ms.client.getCount(ms.end, opts...)
+	return ms.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of MultiplayerModes available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which MultiplayerModes to count.
+func (ms *MultiplayerModeService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ms.client.getCountWithContext(ctx, ms.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count MultiplayerModes")
 	}
@@ -102,7 +138,14 @@ func (ms *MultiplayerModeService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB MultiplayerMode object.
 func (ms *MultiplayerModeService) Fields() ([]string, error) {
-	f, err := ms.client.getFields(ms.end)
+	return ms.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// MultiplayerMode object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ms *MultiplayerModeService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ms.client.getFieldsWithContext(ctx, ms.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get MultiplayerMode fields")
 	}
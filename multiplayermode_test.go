@@ -49,7 +49,7 @@ func TestMultiplayerModeService_Get(t *testing.T) {
 			defer ts.Close()
 
 			mode, err := c.MultiplayerModes.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestMultiplayerModeService_List(t *testing.T) {
 			defer ts.Close()
 
 			mode, err := c.MultiplayerModes.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestMultiplayerModeService_Index(t *testing.T) {
 			defer ts.Close()
 
 			mode, err := c.MultiplayerModes.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestMultiplayerModeService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.MultiplayerModes.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestMultiplayerModeService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.MultiplayerModes.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
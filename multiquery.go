@@ -0,0 +1,99 @@
+package igdb
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// MaxMultiQueries is the maximum number of queries the IGDB
+// allows in a single multiquery request.
+const MaxMultiQueries = 10
+
+// Errors returned when performing a multiquery.
+var (
+	// ErrEmptyMultiQuery occurs when a multiquery is performed without any queries.
+	ErrEmptyMultiQuery = errors.New("multiquery requires at least one query")
+	// ErrTooManyQueries occurs when a multiquery is performed with more than MaxMultiQueries queries.
+	ErrTooManyQueries = errors.New("multiquery cannot contain more than 10 queries")
+	// ErrDuplicateQueryName occurs when multiple queries in a multiquery share the same name.
+	ErrDuplicateQueryName = errors.New("multiquery query names must be unique")
+)
+
+// MultiQuery is a single named query performed as part of a multiquery.
+// Endpoint is the IGDB endpoint to query (e.g. EndpointGame). To count the
+// matching results instead of retrieving them, append "count" to the
+// endpoint (e.g. EndpointGame + "count"). Name identifies the results of
+// the query and must be unique within a multiquery.
+type MultiQuery struct {
+	Endpoint endpoint
+	Name     string
+	Options  []Option
+}
+
+// MultiQueryResult contains the results of a single named query performed
+// as part of a multiquery. Result contains the raw JSON results which can be
+// unmarshaled into a slice of the queried type (e.g. []*Game). For a count
+// query, Result is empty and Count contains the number of matching results.
+type MultiQueryResult struct {
+	Name   string          `json:"name"`
+	Result json.RawMessage `json:"result"`
+	Count  int             `json:"count"`
+}
+
+// MultiQuery performs each of the provided queries in a single request to the
+// IGDB and returns their results in the same order. At most MaxMultiQueries
+// queries can be performed at once. If any of the queries are invalid, an
+// error is returned.
+//
+// For more information, visit: https://api-docs.igdb.com/#multi-query
+func (c *Client) MultiQuery(queries ...MultiQuery) ([]MultiQueryResult, error) {
+	if len(queries) < 1 {
+		return nil, ErrEmptyMultiQuery
+	}
+
+	if len(queries) > MaxMultiQueries {
+		return nil, errors.Wrapf(ErrTooManyQueries, "cannot perform multiquery with %d queries", len(queries))
+	}
+
+	var b strings.Builder
+	names := make(map[string]bool, len(queries))
+	for _, q := range queries {
+		if blank.Is(q.Name) || blank.Is(string(q.Endpoint)) {
+			return nil, ErrEmptyQry
+		}
+
+		if names[q.Name] {
+			return nil, errors.Wrapf(ErrDuplicateQueryName, "cannot perform multiquery with duplicate name %s", q.Name)
+		}
+		names[q.Name] = true
+
+		unwrapped, err := unwrapOptions(q.Options...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot create multiquery with invalid options for %s", q.Name)
+		}
+
+		qry, err := query(unwrapped...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot create multiquery for %s", q.Name)
+		}
+
+		b.WriteString("query " + strings.TrimSuffix(string(q.Endpoint), "/") + " " + quote(q.Name) + " {" + qry + "};\n")
+	}
+
+	req, err := c.newRequest(EndpointMultiQuery, b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var res []MultiQueryResult
+
+	err = c.send(req, &res)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot perform multiquery")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,130 @@
+package igdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testMultiQueryResp = `[
+	{"name": "Game", "result": [{"id": 1942, "name": "The Witcher 3: Wild Hunt"}]},
+	{"name": "Count", "count": 1234}
+]`
+
+func TestClient_MultiQuery(t *testing.T) {
+	tooMany := make([]MultiQuery, MaxMultiQueries+1)
+	for i := range tooMany {
+		tooMany[i] = MultiQuery{Endpoint: EndpointGame, Name: fmt.Sprintf("Query %d", i)}
+	}
+
+	var tests = []struct {
+		name      string
+		resp      string
+		queries   []MultiQuery
+		wantBody  []string
+		wantGames []*Game
+		wantCount int
+		wantErr   error
+	}{
+		{
+			"Valid response",
+			testMultiQueryResp,
+			[]MultiQuery{
+				{Endpoint: EndpointGame, Name: "Game", Options: []Option{SetFields("name"), SetFilter("id", OpEquals, "1942")}},
+				{Endpoint: EndpointGame + "count", Name: "Count", Options: []Option{SetFilter("rating", OpGreaterThan, "75")}},
+			},
+			[]string{`query games "Game" {`, "fields name; ", "where id = 1942; ", `query games/count "Count" {`, "where rating > 75; "},
+			[]*Game{{ID: 1942, Name: "The Witcher 3: Wild Hunt"}},
+			1234,
+			nil,
+		},
+		{"Zero queries", testMultiQueryResp, nil, nil, nil, 0, ErrEmptyMultiQuery},
+		{"Too many queries", testMultiQueryResp, tooMany, nil, nil, 0, ErrTooManyQueries},
+		{"Empty name", testMultiQueryResp, []MultiQuery{{Endpoint: EndpointGame, Name: " "}}, nil, nil, 0, ErrEmptyQry},
+		{"Empty endpoint", testMultiQueryResp, []MultiQuery{{Name: "Game"}}, nil, nil, 0, ErrEmptyQry},
+		{"Duplicate names", testMultiQueryResp, []MultiQuery{{Endpoint: EndpointGame, Name: "Game"}, {Endpoint: EndpointCover, Name: "Game"}}, nil, nil, 0, ErrDuplicateQueryName},
+		{"Invalid option", testMultiQueryResp, []MultiQuery{{Endpoint: EndpointGame, Name: "Game", Options: []Option{SetLimit(-1)}}}, nil, nil, 0, ErrOutOfRange},
+		{"Empty response", "", []MultiQuery{{Endpoint: EndpointGame, Name: "Game"}}, nil, nil, 0, errInvalidJSON},
+		{"No results", "[]", []MultiQuery{{Endpoint: EndpointGame, Name: "Game"}}, nil, nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body, path string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, path = string(b), r.URL.Path
+
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			res, err := c.MultiQuery(test.queries...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			if path != "/"+string(EndpointMultiQuery) {
+				t.Errorf("got: <%v>, want: <%v>", path, "/"+string(EndpointMultiQuery))
+			}
+
+			for _, want := range test.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+
+			var g []*Game
+			if err := json.Unmarshal(res[0].Result, &g); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGames) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGames)
+			}
+
+			if res[1].Count != test.wantCount {
+				t.Errorf("got: <%v>, want: <%v>", res[1].Count, test.wantCount)
+			}
+		})
+	}
+}
+
+func ExampleClient_MultiQuery() {
+	c := NewClient("YOUR_CLIENT_ID", "YOUR_APP_ACCESS_TOKEN", nil)
+
+	res, err := c.MultiQuery(
+		MultiQuery{Endpoint: EndpointGame, Name: "Game", Options: []Option{SetFields("name"), SetFilter("id", OpEquals, "1942")}},
+		MultiQuery{Endpoint: EndpointCover, Name: "Covers", Options: []Option{SetFields("url"), SetFilter("game", OpEquals, "1942")}},
+		MultiQuery{Endpoint: EndpointGame + "count", Name: "Count", Options: []Option{SetFilter("rating", OpGreaterThan, "75")}},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var g []*Game
+	if err := json.Unmarshal(res[0].Result, &g); err != nil {
+		log.Fatal(err)
+	}
+
+	var covers []*Cover
+	if err := json.Unmarshal(res[1].Result, &covers); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(g[0].Name, covers[0].URL, res[2].Count)
+}
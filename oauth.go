@@ -0,0 +1,153 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// twitchTokenURL is the URL used to retrieve app access tokens from Twitch.
+const twitchTokenURL string = "https://id.twitch.tv/oauth2/token"
+
+// tokenExpiryMargin is how long before its expiry an app access token is refreshed.
+const tokenExpiryMargin = time.Minute
+
+// ErrTokenRequest occurs when Twitch does not provide an app access token
+// for the provided client credentials.
+var ErrTokenRequest = errors.New("cannot retrieve app access token from Twitch")
+
+// tokenSource retrieves, caches, and refreshes app access tokens from Twitch
+// using a client ID and client secret. A tokenSource is safe for concurrent use.
+type tokenSource struct {
+	http     *http.Client
+	url      string
+	clientID string
+	secret   string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// get returns the cached app access token. If there is no cached token or
+// it is about to expire, a new token is retrieved from Twitch first. Concurrent
+// callers wait for a single retrieval rather than each retrieving a token.
+func (ts *tokenSource) get(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token != "" && time.Now().Before(ts.expiry.Add(-tokenExpiryMargin)) {
+		return ts.token, nil
+	}
+
+	form := url.Values{
+		"client_id":     {ts.clientID},
+		"client_secret": {ts.secret},
+		"grant_type":    {"client_credentials"},
+	}
+
+	req, err := http.NewRequest("POST", ts.url+"?"+form.Encode(), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot make app access token request")
+	}
+
+	resp, err := ts.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot send app access token request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Wrapf(ErrTokenRequest, "Twitch responded with status %d", resp.StatusCode)
+	}
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	if tok.AccessToken == "" {
+		return "", errors.Wrap(ErrTokenRequest, "Twitch responded without a token")
+	}
+
+	ts.token = tok.AccessToken
+	ts.expiry = time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second)
+
+	return ts.token, nil
+}
+
+// invalidate discards the provided app access token if it is still the cached
+// token, forcing the next call to get to retrieve a new token. Tokens that have
+// already been replaced are ignored so that concurrent callers rejecting the
+// same token only cause a single refresh.
+func (ts *tokenSource) invalidate(token string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token == token {
+		ts.token = ""
+	}
+}
+
+// NewOAuthClient returns a new Client configured to communicate with the IGDB
+// using the provided Twitch clientID and clientSecret. Instead of a fixed app
+// access token, the Client retrieves its own app access token from Twitch,
+// caches it, and transparently refreshes it when it expires or is rejected by
+// the IGDB. The provided HTTP Client is used for both the token requests and
+// the API calls. If no HTTP Client is provided, a default HTTP client is used
+// instead.
+//
+// For more information, visit: https://api-docs.igdb.com/#authentication
+func NewOAuthClient(clientID string, clientSecret string, custom *http.Client) *Client {
+	c := NewClient(clientID, "", custom)
+	c.auth = &tokenSource{
+		http:     c.http,
+		url:      twitchTokenURL,
+		clientID: clientID,
+		secret:   clientSecret,
+	}
+
+	return c
+}
+
+// authorized sends the provided request a single time with the Client's app
+// access token. If the Client retrieves its own tokens and the IGDB rejects
+// the token, the token is refreshed and the request is sent once more.
+func (c *Client) authorized(req *http.Request) (*http.Response, error) {
+	if c.auth == nil {
+		return c.try(req)
+	}
+
+	tok, err := c.auth.get(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tok)
+
+	resp, err := c.try(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	discard(resp)
+
+	c.auth.invalidate(tok)
+	if tok, err = c.auth.get(req.Context()); err != nil {
+		return nil, err
+	}
+
+	if req, err = rewind(req); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+tok)
+
+	return c.try(req)
+}
@@ -0,0 +1,136 @@
+package igdb
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// testOAuthServer initializes and returns a test server that issues numbered
+// app access tokens from its /token path and serves Games to requests with a
+// valid token. Tokens expire after the provided number of seconds and any
+// token listed in rejected is refused with an Unauthorized status.
+// testOAuthServer also returns a Client configured for the test server and
+// a pointer to the number of tokens issued.
+func testOAuthServer(t *testing.T, expiresIn int, tokenStatus int, rejected ...string) (func(), *Client, *int32) {
+	var issued int32
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			q := r.URL.Query()
+			if q.Get("client_id") != testClientID || q.Get("client_secret") != "secret" || q.Get("grant_type") != "client_credentials" {
+				t.Errorf("got: <%v>, want client credentials", q)
+			}
+
+			if tokenStatus != http.StatusOK {
+				w.WriteHeader(tokenStatus)
+				return
+			}
+
+			n := atomic.AddInt32(&issued, 1)
+			fmt.Fprintf(w, `{"access_token": "token%d", "expires_in": %d, "token_type": "bearer"}`, n, expiresIn)
+			return
+		}
+
+		if r.Header.Get("client-id") != testClientID {
+			t.Errorf("got: <%v>, want: <%v>", r.Header.Get("client-id"), testClientID)
+		}
+
+		auth := r.Header.Get("Authorization")
+		for _, rej := range rejected {
+			if auth == "Bearer "+rej {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		w.Write([]byte(`[{"id": 1}]`))
+	})
+
+	oc := NewOAuthClient(testClientID, "secret", c.http)
+	oc.rootURL = c.rootURL
+	oc.auth.url = c.rootURL + "token"
+
+	return ts.Close, oc, &issued
+}
+
+func TestNewOAuthClient(t *testing.T) {
+	var tests = []struct {
+		name        string
+		expiresIn   int
+		tokenStatus int
+		rejected    []string
+		calls       int
+		wantIssued  int32
+		wantErr     error
+	}{
+		{"Cached token", 3600, http.StatusOK, nil, 3, 1, nil},
+		{"Expired token", 0, http.StatusOK, nil, 3, 3, nil},
+		{"Rejected token", 3600, http.StatusOK, []string{"token1"}, 3, 2, nil},
+		{"Rejected refreshed token", 3600, http.StatusOK, []string{"token1", "token2"}, 1, 2, ErrUnauthorized},
+		{"Token request failure", 3600, http.StatusForbidden, nil, 1, 0, ErrTokenRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			done, c, issued := testOAuthServer(t, test.expiresIn, test.tokenStatus, test.rejected...)
+			defer done()
+
+			for i := 0; i < test.calls; i++ {
+				_, err := c.Games.Get(1)
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+				}
+			}
+
+			if *issued != test.wantIssued {
+				t.Errorf("got: <%v> tokens, want: <%v>", *issued, test.wantIssued)
+			}
+		})
+	}
+}
+
+func TestNewOAuthClient_Concurrent(t *testing.T) {
+	done, c, issued := testOAuthServer(t, 3600, http.StatusOK, "token1")
+	defer done()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Games.Get(1); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(issued) != 2 {
+		t.Errorf("got: <%v> tokens, want: <%v>", atomic.LoadInt32(issued), 2)
+	}
+}
+
+func TestNewOAuthClient_RequestHook(t *testing.T) {
+	closer, c, _ := testOAuthServer(t, 3600, http.StatusOK)
+	defer closer()
+
+	var got http.Header
+	c.OnRequest(func(req *http.Request) {
+		got = req.Header
+	})
+
+	if _, err := c.Games.Get(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Get("Authorization") != "Bearer token1" {
+		t.Errorf("got: <%v>, want: <%v>", got.Get("Authorization"), "Bearer token1")
+	}
+
+	if got.Get("client-id") != testClientID {
+		t.Errorf("got: <%v>, want: <%v>", got.Get("client-id"), testClientID)
+	}
+}
@@ -2,6 +2,7 @@ package igdb
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Henry-Sarabia/apicalypse"
@@ -21,6 +22,10 @@ var (
 	ErrEmptyFilterVals = errors.New("one or more provided filter option values are empty")
 	// ErrOutOfRange occurs when a provided number value is out of valid range.
 	ErrOutOfRange = errors.New("provided option value is out of range")
+	// ErrDuplicateOption occurs when an option that can only be set once is provided more than once.
+	ErrDuplicateOption = errors.New("provided option cannot be set more than once")
+	// ErrOptionConflict occurs when options that cannot be used together are provided, such as a search and a sort order.
+	ErrOptionConflict = errors.New("provided options conflict")
 )
 
 // Option functions are used to set the options for an API call.
@@ -45,7 +50,8 @@ func ComposeOptions(opts ...Option) Option {
 }
 
 // unwrapOptions executes the provided options to retrieve the apicalypse options
-// and check for any errors. The first error encountered will be returned.
+// and check for any errors. The first error encountered will be returned. The
+// resulting set of options is then validated as a whole by validateOptions.
 func unwrapOptions(opts ...Option) ([]apicalypse.Option, error) {
 	unwrapped := make([]apicalypse.Option, len(opts))
 	for i, opt := range opts {
@@ -55,9 +61,204 @@ func unwrapOptions(opts ...Option) ([]apicalypse.Option, error) {
 		}
 	}
 
+	if err := validateOptions(unwrapped); err != nil {
+		return nil, errors.Wrap(err, "cannot unwrap conflicting options")
+	}
+
 	return unwrapped, nil
 }
 
+// clauseOrder is the order in which the query clauses are written to the
+// body of a request.
+var clauseOrder = []string{"search", "fields", "exclude", "where", "sort", "limit", "offset"}
+
+// query returns the query for the provided options with its clauses written
+// in a consistent order. Clauses not found in clauseOrder are written last in
+// alphabetical order, except for the clauses only used by the Client itself.
+func query(opts ...apicalypse.Option) (string, error) {
+	clauses := make(map[string]string)
+	for _, o := range opts {
+		if o == nil {
+			return "", errors.New("a provided option is nil")
+		}
+		if err := o(clauses); err != nil {
+			return "", errors.Wrap(err, "cannot create query")
+		}
+	}
+
+	delete(clauses, clauseSkipCache)
+	delete(clauses, clauseTimeout)
+	delete(clauses, clauseAllowEmpty)
+
+	var b strings.Builder
+	for _, cl := range clauseOrder {
+		if v, ok := clauses[cl]; ok {
+			b.WriteString(cl + " " + v + "; ")
+			delete(clauses, cl)
+		}
+	}
+
+	rest := make([]string, 0, len(clauses))
+	for cl := range clauses {
+		rest = append(rest, cl)
+	}
+	sort.Strings(rest)
+
+	for _, cl := range rest {
+		b.WriteString(cl + " " + clauses[cl] + "; ")
+	}
+
+	return b.String(), nil
+}
+
+// singleClauses maps the query clauses that can only be set once per API call
+// to the name of the functional option that sets them.
+var singleClauses = map[string]string{
+	"fields":  "SetFields",
+	"exclude": "SetExclude",
+	"limit":   "SetLimit",
+	"offset":  "SetOffset",
+	"sort":    "SetOrder",
+	"search":  "search",
+
+	clauseTimeout: "SetRequestTimeout",
+}
+
+// validateOptions checks that none of the provided options set the same
+// single-use query clause, that a search is not combined with a sort order,
+// and that excluded fields are only combined with a request for all fields.
+// The IGDB rejects each of these combinations.
+func validateOptions(opts []apicalypse.Option) error {
+	set := make(map[string]bool)
+	var fields string
+	for _, o := range opts {
+		clauses := make(map[string]string)
+		if err := o(clauses); err != nil {
+			return err
+		}
+
+		if f, ok := clauses["fields"]; ok {
+			fields = f
+		}
+
+		for cl := range clauses {
+			name, ok := singleClauses[cl]
+			if !ok {
+				continue
+			}
+			if set[cl] {
+				return errors.Wrapf(ErrDuplicateOption, "cannot set %s option more than once", name)
+			}
+			set[cl] = true
+		}
+	}
+
+	if set["search"] && set["sort"] {
+		return errors.Wrap(ErrOptionConflict, "cannot use SetOrder option with a search")
+	}
+
+	if set["exclude"] && set["fields"] && fields != "*" {
+		return errors.Wrap(ErrOptionConflict, "cannot use SetExclude option with SetFields option unless all fields are requested")
+	}
+
+	return nil
+}
+
+// withoutClauses returns the provided options with each of the provided query
+// clauses removed. This allows a service function to replace a clause set by
+// the caller, such as the limit, without the options conflicting.
+func withoutClauses(opts []Option, clauses ...string) []Option {
+	skip := make(map[string]bool)
+	for _, cl := range clauses {
+		skip[cl] = true
+	}
+
+	out := make([]Option, len(opts))
+	for i, opt := range opts {
+		opt := opt
+		out[i] = func() (apicalypse.Option, error) {
+			o, err := opt()
+			if err != nil {
+				return nil, err
+			}
+
+			return func(filters map[string]string) error {
+				tmp := make(map[string]string, len(filters))
+				for k, v := range filters {
+					tmp[k] = v
+				}
+
+				if err := o(tmp); err != nil {
+					return err
+				}
+
+				for k, v := range tmp {
+					if !skip[k] {
+						filters[k] = v
+					}
+				}
+
+				return nil
+			}, nil
+		}
+	}
+
+	return out
+}
+
+// withDefaults returns the provided options preceded by each of the provided
+// default options whose query clause is not already set by one of the provided
+// options. This allows a service function to use a default, such as a sort
+// order, that can still be overridden by the caller.
+func withDefaults(opts []Option, defaults ...Option) []Option {
+	set := make(map[string]bool)
+	for _, opt := range opts {
+		for k := range optionClauses(opt) {
+			set[k] = true
+		}
+	}
+
+	var out []Option
+	for _, def := range defaults {
+		overridden := false
+		for k := range optionClauses(def) {
+			if set[k] {
+				overridden = true
+			}
+		}
+
+		if !overridden {
+			out = append(out, def)
+		}
+	}
+
+	return append(out, opts...)
+}
+
+// withIndexDefaults returns the provided options with defaults requesting
+// every field and the maximum limit of results. This is used to retrieve the
+// entirety of a small, mostly static endpoint with a single API call.
+func withIndexDefaults(opts []Option) []Option {
+	return withDefaults(opts, SetFields("*"), SetLimit(maxLimit))
+}
+
+// optionClauses returns the query clauses set by the provided option keyed
+// by clause name (e.g. "sort" or "where"). If the option is invalid, nil is
+// returned.
+func optionClauses(opt Option) map[string]string {
+	o, err := opt()
+	if err != nil {
+		return nil
+	}
+
+	clauses := make(map[string]string)
+	if err := o(clauses); err != nil {
+		return nil
+	}
+
+	return clauses
+}
+
 // order specifies the order in which to organize the results from an API call.
 // There are three orders in which results are organized: relevance, ascending,
 // and descending. Relevance is only available as a default and cannot be
@@ -81,7 +282,7 @@ const (
 func SetOrder(field string, order order) Option {
 	return func() (apicalypse.Option, error) {
 		if blank.Is(field) {
-			return nil, ErrEmptyFields
+			return nil, errors.Wrap(ErrEmptyFields, "SetOrder field is blank")
 		}
 
 		return apicalypse.Sort(field, string(order)), nil
@@ -94,8 +295,8 @@ func SetOrder(field string, order order) Option {
 // For more information, visit: https://api-docs.igdb.com/#pagination
 func SetLimit(lim int) Option {
 	return func() (apicalypse.Option, error) {
-		if lim <= 0 || lim > 500 {
-			return nil, ErrOutOfRange
+		if lim <= 0 || lim > maxLimit {
+			return nil, errors.Wrapf(ErrOutOfRange, "SetLimit value %d is not between 1 and %d", lim, maxLimit)
 		}
 
 		return apicalypse.Limit(lim), nil
@@ -109,7 +310,7 @@ func SetLimit(lim int) Option {
 func SetOffset(off int) Option {
 	return func() (apicalypse.Option, error) {
 		if off < 0 {
-			return nil, ErrOutOfRange
+			return nil, errors.Wrapf(ErrOutOfRange, "SetOffset value %d is negative", off)
 		}
 
 		return apicalypse.Offset(off), nil
@@ -127,16 +328,16 @@ func SetOffset(off int) Option {
 func SetFields(fields ...string) Option {
 	return func() (apicalypse.Option, error) {
 		if len(fields) <= 0 {
-			return nil, ErrEmptyFields
+			return nil, errors.Wrap(ErrEmptyFields, "SetFields has no fields")
 		}
 
 		for _, f := range fields {
 			if blank.Is(f) {
-				return nil, ErrEmptyFields
+				return nil, errors.Wrap(ErrEmptyFields, "SetFields has a blank field")
 			}
 
 			if strings.Contains(f, ".") {
-				return nil, ErrExpandedField
+				return nil, errors.Wrapf(ErrExpandedField, "SetFields field '%s'", f)
 			}
 		}
 
@@ -147,22 +348,24 @@ func SetFields(fields ...string) Option {
 // SetExclude is a functional option used to specify which fields of the
 // requested IGDB object you want the API to exclude. Note that the field
 // string must match an IGDB object's JSON field tag exactly, not the Go struct
-// name.
+// name. SetExclude can only be combined with SetFields when every field is
+// requested using an asterisk (i.e. SetFields("*")), otherwise an error is
+// returned.
 //
 // For more information, visit: https://api-docs.igdb.com/#exclude
 func SetExclude(fields ...string) Option {
 	return func() (apicalypse.Option, error) {
 		if len(fields) <= 0 {
-			return nil, ErrEmptyFields
+			return nil, errors.Wrap(ErrEmptyFields, "SetExclude has no fields")
 		}
 
 		for _, f := range fields {
 			if blank.Is(f) {
-				return nil, ErrEmptyFields
+				return nil, errors.Wrap(ErrEmptyFields, "SetExclude has a blank field")
 			}
 
 			if strings.Contains(f, ".") {
-				return nil, ErrExpandedField
+				return nil, errors.Wrapf(ErrExpandedField, "SetExclude field '%s'", f)
 			}
 		}
 
@@ -199,14 +402,39 @@ const (
 	OpNotContainsAtLeast operator = "%s != (%s)"
 	// OpContainsExactly checks if the the given values exactly match the array.
 	OpContainsExactly operator = "%s = {%s}"
+	// OpContainsSubstring checks if the given value exists within the string. Case insensitive.
+	OpContainsSubstring operator = "%s ~ *%s*"
+	// OpContainsAny checks if any of the given values exist within the array. Equivalent to OpContainsAtLeast.
+	OpContainsAny = OpContainsAtLeast
+	// OpNull checks if a field has no value. Takes no values.
+	OpNull operator = "%s = null"
+	// OpNotNull checks if a field has any value. Takes no values.
+	OpNotNull operator = "%s != null"
 )
 
+// nullary returns true if the operator takes no values, such as OpNull.
+func (op operator) nullary() bool {
+	return strings.Count(string(op), "%s") == 1
+}
+
+// format returns the where clause fragment applying the operator to the
+// provided field and values. The values are joined into a comma separated
+// list and ignored by nullary operators.
+func (op operator) format(field string, vals []string) string {
+	if op.nullary() {
+		return fmt.Sprintf(string(op), field)
+	}
+
+	return fmt.Sprintf(string(op), field, strings.Join(vals, ","))
+}
+
 // SetFilter is a functional option used to filter the results from an API
 // call. Filtering operations need three different arguments: an operator
 // and 2 operands, the field and its value. The provided field and val string
 // act as the operands for the provided operator. If multiple values are provided,
 // they will be concatenated into a comma separated list. If no values are
-// provided, an error is returned.
+// provided, an error is returned, unless the operator takes no values (e.g.
+// OpNull or OpNotNull), in which case any values are ignored.
 //
 // SetFilter is the only option allowed to be set multiple times in a single
 // API call. By default, results are unfiltered.
@@ -214,31 +442,59 @@ const (
 // Note that when filtering a field that consists of an enumerated type (e.g. Gender Code,
 // Feed Category, Game Status, etc.), you must provide the number corresponding
 // to the intended field value. For your convenience, you may also provide the
-// enumerated constant.
+// enumerated constant. The labels returned by the String methods of GameCategory
+// and GameStatus are replaced with their numbers, so SetFilter("category",
+// OpEquals, MainGame.String()) filters by "category = 0".
 //
 // For more information, visit: https://api-docs.igdb.com/#filters
 func SetFilter(field string, op operator, val ...string) Option {
 	return func() (apicalypse.Option, error) {
 		if blank.Is(field) {
-			return nil, ErrEmptyFields
+			return nil, errors.Wrap(ErrEmptyFields, "SetFilter field is blank")
 		}
-		if len(val) <= 0 || blank.Has(val) {
-			return nil, ErrEmptyFilterVals
+		if !op.nullary() && (len(val) <= 0 || blank.Has(val)) {
+			return nil, errors.Wrapf(ErrEmptyFilterVals, "SetFilter values for field '%s'", field)
 		}
 
-		j := strings.Join(val, ",")
-		return apicalypse.Where(fmt.Sprintf(string(op), field, j)), nil
+		vals := make([]string, len(val))
+		for i, v := range val {
+			if n, ok := filterLabels[v]; ok {
+				v = n
+			}
+			vals[i] = v
+		}
+
+		return apicalypse.Where(op.format(field, vals)), nil
+	}
+}
+
+// filterValue is implemented by the enumerated types whose String method
+// returns a label rather than the number the IGDB filters by.
+type filterValue interface {
+	fmt.Stringer
+	filterValue() string
+}
+
+// labelsOf returns a map of the labels of the provided values to the numbers
+// the IGDB filters them by. Labels are never quoted, so they cannot be
+// mistaken for string values.
+func labelsOf(vals ...filterValue) map[string]string {
+	labels := make(map[string]string, len(vals))
+	for _, v := range vals {
+		labels[v.String()] = v.filterValue()
 	}
+
+	return labels
 }
 
 // setSearch is a functional option used to search the IGDB using the
-// provided query.
+// provided query. Backslashes and double quotes in the query are escaped.
 func setSearch(qry string) Option {
 	return func() (apicalypse.Option, error) {
 		if blank.Is(qry) {
-			return nil, ErrEmptyQry
+			return nil, errors.Wrap(ErrEmptyQry, "search query is blank")
 		}
 
-		return apicalypse.Search("", qry), nil
+		return apicalypse.Search("", escape(qry)), nil
 	}
 }
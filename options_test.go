@@ -27,7 +27,7 @@ func TestComposeOptions(t *testing.T) {
 	for _, test := range optTests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := ComposeOptions(test.opts...)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -87,11 +87,83 @@ func TestUnwrapOptions(t *testing.T) {
 			nil,
 			ErrOutOfRange,
 		},
+		{
+			"Multiple filters",
+			[]Option{SetFilter("rating", OpGreaterThan, "80"), SetFilter("category", OpEquals, "0")},
+			[]string{"rating > 80", "category = 0"},
+			nil,
+		},
+		{
+			"Search with filter",
+			[]Option{setSearch("mario"), SetFilter("rating", OpGreaterThan, "80")},
+			[]string{`search "mario"`, "rating > 80"},
+			nil,
+		},
+		{
+			"Duplicate fields",
+			[]Option{SetFields("name"), SetFields("rating")},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate exclude",
+			[]Option{SetExclude("name"), SetExclude("rating")},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate limit",
+			[]Option{SetLimit(10), SetLimit(20)},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate offset",
+			[]Option{SetOffset(10), SetOffset(20)},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate order",
+			[]Option{SetOrder("name", OrderAscending), SetOrder("rating", OrderDescending)},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate search",
+			[]Option{setSearch("mario"), setSearch("zelda")},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Duplicate within composed option",
+			[]Option{ComposeOptions(SetLimit(10)), SetLimit(20)},
+			nil,
+			ErrDuplicateOption,
+		},
+		{
+			"Search with order",
+			[]Option{setSearch("mario"), SetOrder("rating", OrderDescending)},
+			nil,
+			ErrOptionConflict,
+		},
+		{
+			"Exclude with all fields",
+			[]Option{SetFields("*"), SetExclude("summary")},
+			[]string{"fields *;", "exclude summary;"},
+			nil,
+		},
+		{
+			"Exclude with specific fields",
+			[]Option{SetFields("name"), SetExclude("summary")},
+			nil,
+			ErrOptionConflict,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			opts, err := unwrapOptions(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -113,6 +185,229 @@ func TestUnwrapOptions(t *testing.T) {
 	}
 }
 
+func TestUnwrapOptions_ErrorNamesOption(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		wantName string
+	}{
+		{"Blank order field", []Option{SetOrder("", OrderAscending)}, "SetOrder"},
+		{"Limit too low", []Option{SetLimit(0)}, "SetLimit"},
+		{"Limit too high", []Option{SetLimit(501)}, "SetLimit"},
+		{"Negative offset", []Option{SetOffset(-1)}, "SetOffset"},
+		{"Empty fields", []Option{SetFields()}, "SetFields"},
+		{"Blank field", []Option{SetFields("name", " ")}, "SetFields"},
+		{"Expanded field", []Option{SetFields("cover.url")}, "SetFields"},
+		{"Empty exclude", []Option{SetExclude()}, "SetExclude"},
+		{"Blank exclude", []Option{SetExclude("")}, "SetExclude"},
+		{"Blank filter field", []Option{SetFilter("", OpEquals, "1")}, "SetFilter"},
+		{"Empty filter values", []Option{SetFilter("id", OpEquals)}, "SetFilter"},
+		{"Blank search", []Option{setSearch(" ")}, "search"},
+		{"Duplicate fields", []Option{SetFields("name"), SetFields("rating")}, "SetFields"},
+		{"Duplicate exclude", []Option{SetExclude("name"), SetExclude("rating")}, "SetExclude"},
+		{"Duplicate limit", []Option{SetLimit(1), SetLimit(2)}, "SetLimit"},
+		{"Duplicate offset", []Option{SetOffset(1), SetOffset(2)}, "SetOffset"},
+		{"Duplicate order", []Option{SetOrder("name", OrderAscending), SetOrder("name", OrderDescending)}, "SetOrder"},
+		{"Search with order", []Option{setSearch("mario"), SetOrder("name", OrderAscending)}, "SetOrder"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := unwrapOptions(test.opts...)
+			if err == nil {
+				t.Fatal("got: <nil>, want: error")
+			}
+
+			if !strings.Contains(err.Error(), test.wantName) {
+				t.Errorf("got: <%v>, want error naming: <%v>", err, test.wantName)
+			}
+		})
+	}
+}
+
+func TestWithoutClauses(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		clauses  []string
+		extra    []Option
+		wantOpts []string
+		wantErr  error
+	}{
+		{
+			"Remove limit",
+			[]Option{SetLimit(10), SetFilter("rating", OpGreaterThan, "80")},
+			[]string{"limit"},
+			[]Option{SetLimit(500)},
+			[]string{"limit 500;", "where rating > 80;"},
+			nil,
+		},
+		{
+			"Remove clause from composed option",
+			[]Option{ComposeOptions(SetFields("name"), SetLimit(10), SetOffset(5))},
+			[]string{"limit", "offset"},
+			[]Option{SetLimit(500), SetOffset(0)},
+			[]string{"fields name;", "limit 500;", "offset 0;"},
+			nil,
+		},
+		{
+			"Filters combined",
+			[]Option{SetFilter("rating", OpGreaterThan, "80"), SetFilter("category", OpEquals, "0")},
+			[]string{"limit"},
+			nil,
+			[]string{"rating > 80", "category = 0"},
+			nil,
+		},
+		{
+			"Invalid option",
+			[]Option{SetLimit(-1)},
+			[]string{"limit"},
+			nil,
+			nil,
+			ErrOutOfRange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := append(withoutClauses(test.opts, test.clauses...), test.extra...)
+
+			unwrapped, err := unwrapOptions(opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			qry, err := apicalypse.Query(unwrapped...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.wantOpts {
+				if !strings.Contains(qry, want) {
+					t.Errorf("got: <%v>, want: <%v>", qry, want)
+				}
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantQry string
+	}{
+		{"Zero options", nil, ""},
+		{"Single option", []Option{SetLimit(10)}, "limit 10; "},
+		{
+			"Exclude with filter, order, and limit",
+			[]Option{SetLimit(5), SetOrder("rating", OrderDescending), SetFilter("rating", OpGreaterThan, "80"), SetExclude("summary", "storyline"), SetFields("*")},
+			"fields *; exclude summary,storyline; where rating > 80; sort rating desc; limit 5; ",
+		},
+		{
+			"Search with fields, filter, and offset",
+			[]Option{SetOffset(20), SetFilter("category", OpEquals, "0"), SetFields("name"), setSearch("mario")},
+			`search "mario"; fields name; where category = 0; offset 20; `,
+		},
+		{
+			"Multiple filters",
+			[]Option{SetFilter("rating", OpGreaterThan, "80"), SetFilter("category", OpEquals, "0")},
+			"where category = 0 & rating > 80; ",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				unwrapped, err := unwrapOptions(test.opts...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := query(unwrapped...)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got != test.wantQry {
+					t.Fatalf("got: <%v>, want: <%v>", got, test.wantQry)
+				}
+			}
+		})
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		defaults []Option
+		wantOpts []string
+		notOpts  []string
+	}{
+		{
+			"Zero options",
+			nil,
+			[]Option{SetOrder("rating", OrderDescending)},
+			[]string{"sort rating desc;"},
+			nil,
+		},
+		{
+			"Unrelated options",
+			[]Option{SetLimit(10), SetFields("name")},
+			[]Option{SetOrder("rating", OrderDescending)},
+			[]string{"sort rating desc;", "limit 10;", "fields name;"},
+			nil,
+		},
+		{
+			"Overridden default",
+			[]Option{SetOrder("name", OrderAscending)},
+			[]Option{SetOrder("rating", OrderDescending), SetLimit(50)},
+			[]string{"sort name asc;", "limit 50;"},
+			[]string{"sort rating desc;"},
+		},
+		{
+			"Invalid option",
+			[]Option{SetLimit(-99999)},
+			[]Option{SetLimit(50)},
+			[]string{"limit 50;"},
+			nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := withDefaults(test.opts, test.defaults...)
+
+			unwrapped := make([]apicalypse.Option, 0, len(opts))
+			for _, opt := range opts {
+				o, err := opt()
+				if err != nil {
+					continue
+				}
+				unwrapped = append(unwrapped, o)
+			}
+
+			qry, err := apicalypse.Query(unwrapped...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.wantOpts {
+				if !strings.Contains(qry, want) {
+					t.Errorf("got: <%v>, want: <%v>", qry, want)
+				}
+			}
+
+			for _, not := range test.notOpts {
+				if strings.Contains(qry, not) {
+					t.Errorf("got: <%v>, want query without: <%v>", qry, not)
+				}
+			}
+		})
+	}
+}
+
 func TestSetOrder(t *testing.T) {
 	var tests = []struct {
 		name    string
@@ -130,7 +425,7 @@ func TestSetOrder(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetOrder(test.field, test.order)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -166,7 +461,7 @@ func TestSetLimit(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetLimit(test.limit)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -201,7 +496,7 @@ func TestSetOffset(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetOffset(test.offset)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -241,7 +536,7 @@ func TestSetFields(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetFields(test.fields...)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -281,7 +576,7 @@ func TestSetExclude(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetExclude(test.fields...)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -318,11 +613,16 @@ func TestSetFilter(t *testing.T) {
 		{"Empty field and non-empty value", "", OpEquals, []string{"Megaman X1"}, "", ErrEmptyFields},
 		{"Empty field and empty value", "", OpEquals, []string{""}, "", ErrEmptyFields},
 		{"Empty field and no values", "", OpEquals, nil, "", ErrEmptyFields},
+		{"Null operator and no values", "cover", OpNull, nil, "cover = null", nil},
+		{"Not null operator and ignored values", "cover", OpNotNull, []string{"1"}, "cover != null", nil},
+		{"GameCategory label", "category", OpEquals, []string{MainGame.String()}, "where category = 0;", nil},
+		{"GameStatus labels", "status", OpContainsAtLeast, []string{StatusAlpha.String(), StatusEarlyAccess.String()}, "where status = (2,4);", nil},
+		{"Quoted label", "name", OpEquals, []string{`"update"`}, `where name = "update";`, nil},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := SetFilter(test.field, test.op, test.vals...)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -351,13 +651,15 @@ func TestSetSearch(t *testing.T) {
 	}{
 		{"Non-empty query", "zelda", "zelda", nil},
 		{"Non-Empty query with spaces", "the legend of zelda", "the legend of zelda", nil},
+		{"Quoted query", `tom clancy's "the division"`, `search "tom clancy's \"the division\"";`, nil},
+		{"Backslash query", `half\life`, `search "half\\life";`, nil},
 		{"Empty query", "", "", ErrEmptyQry},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			fn, err := setSearch(test.qry)()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -486,23 +788,11 @@ func ExampleSetFields() {
 func ExampleSetExclude() {
 	c := NewClient("YOUR_CLIENT_ID", "YOUR_APP_ACCESS_TOKEN", nil)
 
-	// Exclude name field
-	c.Characters.Search("mario", SetFields("name"))
-
-	// Exclude gender field
-	c.Characters.Search("mario", SetFields("gender"))
-
-	// Exclude both name and gender field
-	c.Characters.Search("mario", SetFields("name", "gender"))
+	// Retrieve all available fields except summary
+	c.Games.Search("mario", SetFields("*"), SetExclude("summary"))
 
-	// Exclude whole mug_shot field
-	c.Characters.Search("mario", SetFields("mug_shot"))
-
-	// Exclude any number of fields
-	c.Characters.Search("mario", SetFields("name", "gender", "url", "species", "games", "mug_shot"))
-
-	// Exclude all available fields
-	c.Characters.Search("mario", SetFields("*"))
+	// Retrieve all available fields except summary and storyline
+	c.Games.Search("mario", SetFields("*"), SetExclude("summary", "storyline"))
 }
 
 func ExampleSetFilter() {
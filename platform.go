@@ -1,6 +1,8 @@
 package igdb
 
 import (
+	"context"
+	"github.com/Henry-Sarabia/blank"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -16,14 +18,14 @@ type Platform struct {
 	Abbreviation    string           `json:"abbreviation"`
 	AlternativeName string           `json:"alternative_name"`
 	Category        PlatformCategory `json:"category"`
-	CreatedAt       int              `json:"created_at"`
+	CreatedAt       Timestamp        `json:"created_at"`
 	Generation      int              `json:"generation"`
 	Name            string           `json:"name"`
 	PlatformLogo    int              `json:"platform_logo"`
-	ProductFamily   int              `json:"product_family"`
+	ProductFamily   int              `json:"platform_family"`
 	Slug            string           `json:"slug"`
 	Summary         string           `json:"summary"`
-	UpdatedAt       int              `json:"updated_at"`
+	UpdatedAt       Timestamp        `json:"updated_at"`
 	URL             string           `json:"url"`
 	Versions        []int            `json:"versions"`
 	Websites        []int            `json:"websites"`
@@ -44,6 +46,29 @@ const (
 	PlatformComputer
 )
 
+// LatestGeneration is the most recent generation of video game consoles.
+const LatestGeneration = 9
+
+// DisplayName returns the Platform's abbreviation (e.g. "PS5") if it has
+// one. Otherwise, the Platform's name is returned.
+func (p *Platform) DisplayName() string {
+	if !blank.Is(p.Abbreviation) {
+		return p.Abbreviation
+	}
+
+	return p.Name
+}
+
+// IsCurrentGen reports whether the Platform belongs to the latest generation.
+func (p *Platform) IsCurrentGen() bool {
+	return p.Generation == LatestGeneration
+}
+
+// IsHandheld reports whether the Platform is a portable console.
+func (p *Platform) IsHandheld() bool {
+	return p.Category == PlatformPortableConsole
+}
+
 // PlatformService handles all the API calls for the IGDB Platform endpoint.
 type PlatformService service
 
@@ -51,14 +76,23 @@ type PlatformService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Platforms, an error is returned.
 func (ps *PlatformService) Get(id int, opts ...Option) (*Platform, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Platform identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Platforms, an error is
+// returned.
+func (ps *PlatformService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Platform, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var plat []*Platform
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &plat, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &plat, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Platform with ID %v", id)
 	}
@@ -71,6 +105,15 @@ func (ps *PlatformService) Get(id int, opts ...Option) (*Platform, error) {
 // Any ID that does not match a Platform is ignored. If none of the IDs
 // match a Platform, an error is returned.
 func (ps *PlatformService) List(ids []int, opts ...Option) ([]*Platform, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Platforms identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Platform is ignored. If
+// none of the IDs match a Platform, an error is returned.
+func (ps *PlatformService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Platform, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -84,7 +127,7 @@ func (ps *PlatformService) List(ids []int, opts ...Option) ([]*Platform, error)
 	var plat []*Platform
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &plat, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &plat, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Platforms with IDs %v", ids)
 	}
@@ -96,9 +139,18 @@ func (ps *PlatformService) List(ids []int, opts ...Option) ([]*Platform, error)
 // options used to sort, filter, and paginate the results. If no Platforms can
 // be found using the provided options, an error is returned.
 func (ps *PlatformService) Index(opts ...Option) ([]*Platform, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Platforms based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Platforms can be found using the provided options, an error
+// is returned.
+func (ps *PlatformService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Platform, error) {
 	var plat []*Platform
 
-	err := ps.client.post(ps.end, &plat, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &plat, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Platforms")
 	}
@@ -110,10 +162,19 @@ func (ps *PlatformService) Index(opts ...Option) ([]*Platform, error) {
 // query. Provide functional options to sort, filter, and paginate the results. If
 // no Platforms are found using the provided query, an error is returned.
 func (ps *PlatformService) Search(qry string, opts ...Option) ([]*Platform, error) {
+	return ps.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Platforms found by searching the IGDB
+// using the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Platforms are found using the
+// provided query, an error is returned.
+func (ps *PlatformService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Platform, error) {
 	var plat []*Platform
 
 	opts = append(opts, setSearch(qry))
-	err := ps.client.post(ps.end, &plat, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &plat, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Platform with query %s", qry)
 	}
@@ -121,11 +182,72 @@ func (ps *PlatformService) Search(qry string, opts ...Option) ([]*Platform, erro
 	return plat, nil
 }
 
+// ByGeneration returns a list of Platforms belonging to the provided console
+// generation (e.g. 8 for the PlayStation 4 and Xbox One). Provide functional
+// options to sort, filter, and paginate the results. If the generation is not
+// positive, ErrOutOfRange is returned. If no Platforms are found, an error is
+// returned.
+func (ps *PlatformService) ByGeneration(gen int, opts ...Option) ([]*Platform, error) {
+	if gen < 1 {
+		return nil, errors.Wrapf(ErrOutOfRange, "generation %d", gen)
+	}
+
+	var plat []*Platform
+
+	opts = append(opts, SetFilter("generation", OpEquals, strconv.Itoa(gen)))
+	err := ps.client.post(ps.end, &plat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Platforms of generation %v", gen)
+	}
+
+	return plat, nil
+}
+
+// GetByWebsite returns the Platform whose official website matches the
+// provided URL (e.g. "store.playstation.com"). The URL is first matched
+// against the PlatformWebsites, then the owning Platform is retrieved in a
+// second request. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the URL does not match any Platform,
+// an error is returned.
+func (ps *PlatformService) GetByWebsite(url string, opts ...Option) (*Platform, error) {
+	if blank.Is(url) {
+		return nil, ErrEmptyQry
+	}
+
+	web, err := ps.client.PlatformWebsites.Index(SetFields("id"), SetFilter("url", OpContainsSubstring, quote(url)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Platform with website %s", url)
+	}
+
+	ids := make([]int, len(web))
+	for i, w := range web {
+		ids[i] = w.ID
+	}
+
+	var plat []*Platform
+
+	opts = append(requireResults(opts), SetFilter("websites", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err = ps.client.post(ps.end, &plat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Platform with website %s", url)
+	}
+
+	return plat[0], nil
+}
+
 // Count returns the number of Platforms available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Platforms to count.
 func (ps *PlatformService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Platforms available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Platforms to count.
+func (ps *PlatformService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Platforms")
 	}
@@ -136,7 +258,14 @@ func (ps *PlatformService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Platform object.
 func (ps *PlatformService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Platform
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ps *PlatformService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Platform fields")
 	}
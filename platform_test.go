@@ -15,6 +15,52 @@ const (
 	testPlatformSearch string = "test_data/platform_search.json"
 )
 
+func TestPlatform_DisplayName(t *testing.T) {
+	var tests = []struct {
+		name     string
+		plat     *Platform
+		wantName string
+	}{
+		{"Abbreviation", &Platform{Abbreviation: "PS5", Name: "PlayStation 5"}, "PS5"},
+		{"Blank abbreviation", &Platform{Abbreviation: " ", Name: "PlayStation 5"}, "PlayStation 5"},
+		{"Name only", &Platform{Name: "PlayStation 5"}, "PlayStation 5"},
+		{"Neither", &Platform{}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.plat.DisplayName() != test.wantName {
+				t.Errorf("got: <%v>, want: <%v>", test.plat.DisplayName(), test.wantName)
+			}
+		})
+	}
+}
+
+func TestPlatform_Predicates(t *testing.T) {
+	var tests = []struct {
+		name         string
+		plat         *Platform
+		wantCurrent  bool
+		wantHandheld bool
+	}{
+		{"Current generation console", &Platform{Category: PlatformConsole, Generation: LatestGeneration}, true, false},
+		{"Previous generation handheld", &Platform{Category: PlatformPortableConsole, Generation: 8}, false, true},
+		{"Computer", &Platform{Category: PlatformComputer}, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.plat.IsCurrentGen() != test.wantCurrent {
+				t.Errorf("IsCurrentGen got: <%v>, want: <%v>", test.plat.IsCurrentGen(), test.wantCurrent)
+			}
+
+			if test.plat.IsHandheld() != test.wantHandheld {
+				t.Errorf("IsHandheld got: <%v>, want: <%v>", test.plat.IsHandheld(), test.wantHandheld)
+			}
+		})
+	}
+}
+
 func TestPlatformService_Get(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlatformGet)
 	if err != nil {
@@ -27,6 +73,10 @@ func TestPlatformService_Get(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if init[0].ProductFamily == 0 {
+		t.Fatalf("got: <%v>, want ProductFamily decoded from platform_family", init[0].ProductFamily)
+	}
+
 	var tests = []struct {
 		name         string
 		file         string
@@ -50,7 +100,7 @@ func TestPlatformService_Get(t *testing.T) {
 			defer ts.Close()
 
 			plat, err := c.Platforms.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -97,7 +147,7 @@ func TestPlatformService_List(t *testing.T) {
 			defer ts.Close()
 
 			plat, err := c.Platforms.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -141,13 +191,75 @@ func TestPlatformService_Index(t *testing.T) {
 			defer ts.Close()
 
 			plat, err := c.Platforms.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(plat, test.wantPlatforms) {
+				t.Errorf("got: <%v>, \nwant: <%v>", plat, test.wantPlatforms)
+			}
+		})
+	}
+}
+
+func TestPlatformService_ByGeneration(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Platform, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		file          string
+		gen           int
+		opts          []Option
+		wantQry       string
+		wantPlatforms []*Platform
+		wantErr       error
+	}{
+		{"Valid response", testPlatformList, 8, []Option{SetLimit(5)}, "where generation = 8; limit 5; ", init, nil},
+		{"Zero generation", testFileEmpty, 0, nil, "", nil, ErrOutOfRange},
+		{"Negative generation", testFileEmpty, -1, nil, "", nil, ErrOutOfRange},
+		{"Empty response", testFileEmpty, 8, nil, "where generation = 8; ", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 8, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 99, nil, "where generation = 99; ", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			plat, err := c.Platforms.ByGeneration(test.gen, test.opts...)
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
 			if !reflect.DeepEqual(plat, test.wantPlatforms) {
 				t.Errorf("got: <%v>, \nwant: <%v>", plat, test.wantPlatforms)
 			}
+
+			if body != test.wantQry {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantQry)
+			}
 		})
 	}
 }
@@ -187,7 +299,7 @@ func TestPlatformService_Search(t *testing.T) {
 			defer ts.Close()
 
 			plat, err := c.Platforms.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -198,6 +310,62 @@ func TestPlatformService_Search(t *testing.T) {
 	}
 }
 
+func TestPlatformService_GetByWebsite(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Platform, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		webFile  string
+		platFile string
+		url      string
+		opts     []Option
+		wantPlat *Platform
+		wantErr  error
+	}{
+		{"Valid response", testPlatformWebsiteList, testPlatformGet, "playstation.com", []Option{SetFields("name")}, init[0], nil},
+		{"Empty URL", testFileEmpty, testFileEmpty, "  ", nil, nil, ErrEmptyQry},
+		{"No matching websites", testFileEmptyArray, testPlatformGet, "example.com", nil, nil, ErrNoResults},
+		{"No matching platforms", testPlatformWebsiteList, testFileEmptyArray, "playstation.com", nil, nil, ErrNoResults},
+		{"Empty response", testPlatformWebsiteList, testFileEmpty, "playstation.com", nil, nil, errInvalidJSON},
+		{"Invalid option", testPlatformWebsiteList, testPlatformGet, "playstation.com", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				file := test.platFile
+				if r.URL.Path == "/"+string(EndpointPlatformWebsite) {
+					file = test.webFile
+				}
+
+				b, err := ioutil.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(b)
+			})
+			defer ts.Close()
+
+			plat, err := c.Platforms.GetByWebsite(test.url, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(plat, test.wantPlat) {
+				t.Errorf("got: <%v>, \nwant: <%v>", plat, test.wantPlat)
+			}
+		})
+	}
+}
+
 func TestPlatformService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -218,7 +386,7 @@ func TestPlatformService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Platforms.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -249,7 +417,7 @@ func TestPlatformService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Platforms.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -24,14 +25,23 @@ type PlatformFamilyService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformFamilies, an error is returned.
 func (ps *PlatformFamilyService) Get(id int, opts ...Option) (*PlatformFamily, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformFamily identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// PlatformFamilies, an error is returned.
+func (ps *PlatformFamilyService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformFamily, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var fam []*PlatformFamily
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &fam, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &fam, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformFamily with ID %v", id)
 	}
@@ -44,6 +54,15 @@ func (ps *PlatformFamilyService) Get(id int, opts ...Option) (*PlatformFamily, e
 // Any ID that does not match a PlatformFamily is ignored. If none of the IDs
 // match a PlatformFamily, an error is returned.
 func (ps *PlatformFamilyService) List(ids []int, opts ...Option) ([]*PlatformFamily, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformFamilies identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PlatformFamily is ignored.
+// If none of the IDs match a PlatformFamily, an error is returned.
+func (ps *PlatformFamilyService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformFamily, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -57,7 +76,7 @@ func (ps *PlatformFamilyService) List(ids []int, opts ...Option) ([]*PlatformFam
 	var fam []*PlatformFamily
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &fam, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &fam, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformFamilies with IDs %v", ids)
 	}
@@ -69,9 +88,18 @@ func (ps *PlatformFamilyService) List(ids []int, opts ...Option) ([]*PlatformFam
 // options used to sort, filter, and paginate the results. If no PlatformFamilies can
 // be found using the provided options, an error is returned.
 func (ps *PlatformFamilyService) Index(opts ...Option) ([]*PlatformFamily, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformFamilies based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no PlatformFamilies can be found using the provided options,
+// an error is returned.
+func (ps *PlatformFamilyService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformFamily, error) {
 	var fam []*PlatformFamily
 
-	err := ps.client.post(ps.end, &fam, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &fam, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformFamilies")
 	}
@@ -83,7 +111,15 @@ func (ps *PlatformFamilyService) Index(opts ...Option) ([]*PlatformFamily, error
 // Provide the SetFilter functional option if you need to filter
 // which PlatformFamilies to count.
 func (ps *PlatformFamilyService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformFamilies available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which PlatformFamilies to count.
+func (ps *PlatformFamilyService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformFamilies")
 	}
@@ -94,7 +130,14 @@ func (ps *PlatformFamilyService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformFamily object.
 func (ps *PlatformFamilyService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformFamily object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PlatformFamilyService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformFamily fields")
 	}
@@ -49,7 +49,7 @@ func TestPlatformFamilyService_Get(t *testing.T) {
 			defer ts.Close()
 
 			fam, err := c.PlatformFamilies.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestPlatformFamilyService_List(t *testing.T) {
 			defer ts.Close()
 
 			fam, err := c.PlatformFamilies.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestPlatformFamilyService_Index(t *testing.T) {
 			defer ts.Close()
 
 			fam, err := c.PlatformFamilies.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestPlatformFamilyService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.PlatformFamilies.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestPlatformFamilyService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.PlatformFamilies.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
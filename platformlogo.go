@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -20,14 +21,23 @@ type PlatformLogoService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformLogos, an error is returned.
 func (ps *PlatformLogoService) Get(id int, opts ...Option) (*PlatformLogo, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformLogo identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any PlatformLogos,
+// an error is returned.
+func (ps *PlatformLogoService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformLogo, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var logo []*PlatformLogo
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &logo, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformLogo with ID %v", id)
 	}
@@ -40,6 +50,15 @@ func (ps *PlatformLogoService) Get(id int, opts ...Option) (*PlatformLogo, error
 // Any ID that does not match a PlatformLogo is ignored. If none of the IDs
 // match a PlatformLogo, an error is returned.
 func (ps *PlatformLogoService) List(ids []int, opts ...Option) ([]*PlatformLogo, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformLogos identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PlatformLogo is ignored.
+// If none of the IDs match a PlatformLogo, an error is returned.
+func (ps *PlatformLogoService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformLogo, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -53,7 +72,7 @@ func (ps *PlatformLogoService) List(ids []int, opts ...Option) ([]*PlatformLogo,
 	var logo []*PlatformLogo
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &logo, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformLogos with IDs %v", ids)
 	}
@@ -65,9 +84,18 @@ func (ps *PlatformLogoService) List(ids []int, opts ...Option) ([]*PlatformLogo,
 // options used to sort, filter, and paginate the results. If no PlatformLogos can
 // be found using the provided options, an error is returned.
 func (ps *PlatformLogoService) Index(opts ...Option) ([]*PlatformLogo, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformLogos based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no PlatformLogos can be found using the provided options, an
+// error is returned.
+func (ps *PlatformLogoService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformLogo, error) {
 	var logo []*PlatformLogo
 
-	err := ps.client.post(ps.end, &logo, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &logo, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformLogos")
 	}
@@ -79,7 +107,15 @@ func (ps *PlatformLogoService) Index(opts ...Option) ([]*PlatformLogo, error) {
 // Provide the SetFilter functional option if you need to filter
 // which PlatformLogos to count.
 func (ps *PlatformLogoService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformLogos available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which PlatformLogos to count.
+func (ps *PlatformLogoService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformLogos")
 	}
@@ -90,7 +126,14 @@ func (ps *PlatformLogoService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformLogo object.
 func (ps *PlatformLogoService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformLogo object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PlatformLogoService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformLogo fields")
 	}
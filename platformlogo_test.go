@@ -49,7 +49,7 @@ func TestPlatformLogoService_Get(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.PlatformLogos.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestPlatformLogoService_List(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.PlatformLogos.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestPlatformLogoService_Index(t *testing.T) {
 			defer ts.Close()
 
 			logo, err := c.PlatformLogos.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestPlatformLogoService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.PlatformLogos.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestPlatformLogoService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.PlatformLogos.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -39,14 +40,23 @@ type PlatformVersionService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformVersions, an error is returned.
 func (ps *PlatformVersionService) Get(id int, opts ...Option) (*PlatformVersion, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformVersion identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// PlatformVersions, an error is returned.
+func (ps *PlatformVersionService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformVersion, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var ver []*PlatformVersion
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &ver, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersion with ID %v", id)
 	}
@@ -59,6 +69,15 @@ func (ps *PlatformVersionService) Get(id int, opts ...Option) (*PlatformVersion,
 // Any ID that does not match a PlatformVersion is ignored. If none of the IDs
 // match a PlatformVersion, an error is returned.
 func (ps *PlatformVersionService) List(ids []int, opts ...Option) ([]*PlatformVersion, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformVersions identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PlatformVersion is
+// ignored. If none of the IDs match a PlatformVersion, an error is returned.
+func (ps *PlatformVersionService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersion, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -72,7 +91,7 @@ func (ps *PlatformVersionService) List(ids []int, opts ...Option) ([]*PlatformVe
 	var ver []*PlatformVersion
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &ver, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersions with IDs %v", ids)
 	}
@@ -84,9 +103,18 @@ func (ps *PlatformVersionService) List(ids []int, opts ...Option) ([]*PlatformVe
 // options used to sort, filter, and paginate the results. If no PlatformVersions can
 // be found using the provided options, an error is returned.
 func (ps *PlatformVersionService) Index(opts ...Option) ([]*PlatformVersion, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformVersions based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no PlatformVersions can be found using the provided options,
+// an error is returned.
+func (ps *PlatformVersionService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformVersion, error) {
 	var ver []*PlatformVersion
 
-	err := ps.client.post(ps.end, &ver, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &ver, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformVersions")
 	}
@@ -98,7 +126,15 @@ func (ps *PlatformVersionService) Index(opts ...Option) ([]*PlatformVersion, err
 // Provide the SetFilter functional option if you need to filter
 // which PlatformVersions to count.
 func (ps *PlatformVersionService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformVersions available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which PlatformVersions to count.
+func (ps *PlatformVersionService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformVersions")
 	}
@@ -109,7 +145,14 @@ func (ps *PlatformVersionService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformVersion object.
 func (ps *PlatformVersionService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformVersion object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PlatformVersionService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformVersion fields")
 	}
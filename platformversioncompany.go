@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -25,14 +26,23 @@ type PlatformVersionCompanyService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformVersionCompanies, an error is returned.
 func (ps *PlatformVersionCompanyService) Get(id int, opts ...Option) (*PlatformVersionCompany, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformVersionCompany identified by the
+// provided IGDB ID. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide the SetFields functional option if you
+// need to specify which fields to retrieve. If the ID does not match any
+// PlatformVersionCompanies, an error is returned.
+func (ps *PlatformVersionCompanyService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformVersionCompany, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var com []*PlatformVersionCompany
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &com, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersionCompany with ID %v", id)
 	}
@@ -45,6 +55,16 @@ func (ps *PlatformVersionCompanyService) Get(id int, opts ...Option) (*PlatformV
 // Any ID that does not match a PlatformVersionCompany is ignored. If none of the IDs
 // match a PlatformVersionCompany, an error is returned.
 func (ps *PlatformVersionCompanyService) List(ids []int, opts ...Option) ([]*PlatformVersionCompany, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformVersionCompanies identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// PlatformVersionCompany is ignored. If none of the IDs match a
+// PlatformVersionCompany, an error is returned.
+func (ps *PlatformVersionCompanyService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersionCompany, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -58,7 +78,7 @@ func (ps *PlatformVersionCompanyService) List(ids []int, opts ...Option) ([]*Pla
 	var com []*PlatformVersionCompany
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &com, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersionCompanies with IDs %v", ids)
 	}
@@ -70,9 +90,18 @@ func (ps *PlatformVersionCompanyService) List(ids []int, opts ...Option) ([]*Pla
 // options used to sort, filter, and paginate the results. If no PlatformVersionCompanies can
 // be found using the provided options, an error is returned.
 func (ps *PlatformVersionCompanyService) Index(opts ...Option) ([]*PlatformVersionCompany, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformVersionCompanies based solely on
+// the provided functional options used to sort, filter, and paginate the
+// results. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. If no PlatformVersionCompanies can be found using the
+// provided options, an error is returned.
+func (ps *PlatformVersionCompanyService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformVersionCompany, error) {
 	var com []*PlatformVersionCompany
 
-	err := ps.client.post(ps.end, &com, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &com, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformVersionCompanies")
 	}
@@ -84,7 +113,15 @@ func (ps *PlatformVersionCompanyService) Index(opts ...Option) ([]*PlatformVersi
 // Provide the SetFilter functional option if you need to filter
 // which PlatformVersionCompanies to count.
 func (ps *PlatformVersionCompanyService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformVersionCompanies available in
+// the IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which PlatformVersionCompanies to count.
+func (ps *PlatformVersionCompanyService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformVersionCompanies")
 	}
@@ -95,7 +132,14 @@ func (ps *PlatformVersionCompanyService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformVersionCompany object.
 func (ps *PlatformVersionCompanyService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformVersionCompany object. The request is canceled if the provided
+// context is canceled or its deadline is exceeded.
+func (ps *PlatformVersionCompanyService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformVersionCompany fields")
 	}
@@ -49,7 +49,7 @@ func TestPlatformVersionCompanyService_Get(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.PlatformVersionCompanies.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestPlatformVersionCompanyService_List(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.PlatformVersionCompanies.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestPlatformVersionCompanyService_Index(t *testing.T) {
 			defer ts.Close()
 
 			com, err := c.PlatformVersionCompanies.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestPlatformVersionCompanyService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.PlatformVersionCompanies.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestPlatformVersionCompanyService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.PlatformVersionCompanies.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
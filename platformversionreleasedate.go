@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -14,13 +15,13 @@ import (
 type PlatformVersionReleaseDate struct {
 	ID              int            `json:"id"`
 	Category        DateCategory   `json:"category"`
-	CreatedAt       int            `json:"created_at"`
-	Date            int            `json:"date"`
+	CreatedAt       Timestamp      `json:"created_at"`
+	Date            Timestamp      `json:"date"`
 	Human           string         `json:"human"`
 	M               int            `json:"m"`
 	PlatformVersion int            `json:"platform_version"`
 	Region          RegionCategory `json:"region"`
-	UpdatedAt       int            `json:"updated_at"`
+	UpdatedAt       Timestamp      `json:"updated_at"`
 	Y               int            `json:"y"`
 }
 
@@ -31,14 +32,23 @@ type PlatformVersionReleaseDateService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformVersionReleaseDates, an error is returned.
 func (ps *PlatformVersionReleaseDateService) Get(id int, opts ...Option) (*PlatformVersionReleaseDate, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformVersionReleaseDate identified by the
+// provided IGDB ID. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide the SetFields functional option if you
+// need to specify which fields to retrieve. If the ID does not match any
+// PlatformVersionReleaseDates, an error is returned.
+func (ps *PlatformVersionReleaseDateService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformVersionReleaseDate, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var date []*PlatformVersionReleaseDate
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &date, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersionReleaseDate with ID %v", id)
 	}
@@ -51,6 +61,16 @@ func (ps *PlatformVersionReleaseDateService) Get(id int, opts ...Option) (*Platf
 // Any ID that does not match a PlatformVersionReleaseDate is ignored. If none of the IDs
 // match a PlatformVersionReleaseDate, an error is returned.
 func (ps *PlatformVersionReleaseDateService) List(ids []int, opts ...Option) ([]*PlatformVersionReleaseDate, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformVersionReleaseDates identified by
+// the provided list of IGDB IDs. The request is canceled if the provided
+// context is canceled or its deadline is exceeded. Provide functional options
+// to sort, filter, and paginate the results. Any ID that does not match a
+// PlatformVersionReleaseDate is ignored. If none of the IDs match a
+// PlatformVersionReleaseDate, an error is returned.
+func (ps *PlatformVersionReleaseDateService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformVersionReleaseDate, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -64,7 +84,7 @@ func (ps *PlatformVersionReleaseDateService) List(ids []int, opts ...Option) ([]
 	var date []*PlatformVersionReleaseDate
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &date, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformVersionReleaseDates with IDs %v", ids)
 	}
@@ -76,9 +96,18 @@ func (ps *PlatformVersionReleaseDateService) List(ids []int, opts ...Option) ([]
 // options used to sort, filter, and paginate the results. If no PlatformVersionReleaseDates can
 // be found using the provided options, an error is returned.
 func (ps *PlatformVersionReleaseDateService) Index(opts ...Option) ([]*PlatformVersionReleaseDate, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformVersionReleaseDates based solely
+// on the provided functional options used to sort, filter, and paginate the
+// results. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. If no PlatformVersionReleaseDates can be found using
+// the provided options, an error is returned.
+func (ps *PlatformVersionReleaseDateService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformVersionReleaseDate, error) {
 	var date []*PlatformVersionReleaseDate
 
-	err := ps.client.post(ps.end, &date, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformVersionReleaseDates")
 	}
@@ -90,7 +119,15 @@ func (ps *PlatformVersionReleaseDateService) Index(opts ...Option) ([]*PlatformV
 // Provide the SetFilter functional option if you need to filter
 // which PlatformVersionReleaseDates to count.
 func (ps *PlatformVersionReleaseDateService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformVersionReleaseDates available
+// in the IGDB. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide the SetFilter functional option if you need
+// to filter which PlatformVersionReleaseDates to count.
+func (ps *PlatformVersionReleaseDateService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformVersionReleaseDates")
 	}
@@ -101,7 +138,14 @@ func (ps *PlatformVersionReleaseDateService) Count(opts ...Option) (int, error)
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformVersionReleaseDate object.
 func (ps *PlatformVersionReleaseDateService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformVersionReleaseDate object. The request is canceled if the provided
+// context is canceled or its deadline is exceeded.
+func (ps *PlatformVersionReleaseDateService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformVersionReleaseDate fields")
 	}
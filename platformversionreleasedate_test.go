@@ -49,7 +49,7 @@ func TestPlatformVersionReleaseDateService_Get(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.PlatformVersionReleaseDates.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +96,7 @@ func TestPlatformVersionReleaseDateService_List(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.PlatformVersionReleaseDates.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +140,7 @@ func TestPlatformVersionReleaseDateService_Index(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.PlatformVersionReleaseDates.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +171,7 @@ func TestPlatformVersionReleaseDateService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.PlatformVersionReleaseDates.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +202,7 @@ func TestPlatformVersionReleaseDateService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.PlatformVersionReleaseDates.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -22,14 +23,23 @@ type PlatformWebsiteService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlatformWebsites, an error is returned.
 func (ps *PlatformWebsiteService) Get(id int, opts ...Option) (*PlatformWebsite, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlatformWebsite identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// PlatformWebsites, an error is returned.
+func (ps *PlatformWebsiteService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlatformWebsite, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var web []*PlatformWebsite
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &web, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformWebsite with ID %v", id)
 	}
@@ -42,6 +52,15 @@ func (ps *PlatformWebsiteService) Get(id int, opts ...Option) (*PlatformWebsite,
 // Any ID that does not match a PlatformWebsite is ignored. If none of the IDs
 // match a PlatformWebsite, an error is returned.
 func (ps *PlatformWebsiteService) List(ids []int, opts ...Option) ([]*PlatformWebsite, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlatformWebsites identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PlatformWebsite is
+// ignored. If none of the IDs match a PlatformWebsite, an error is returned.
+func (ps *PlatformWebsiteService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlatformWebsite, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -55,7 +74,7 @@ func (ps *PlatformWebsiteService) List(ids []int, opts ...Option) ([]*PlatformWe
 	var web []*PlatformWebsite
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &web, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlatformWebsites with IDs %v", ids)
 	}
@@ -67,9 +86,18 @@ func (ps *PlatformWebsiteService) List(ids []int, opts ...Option) ([]*PlatformWe
 // options used to sort, filter, and paginate the results. If no PlatformWebsites can
 // be found using the provided options, an error is returned.
 func (ps *PlatformWebsiteService) Index(opts ...Option) ([]*PlatformWebsite, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlatformWebsites based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no PlatformWebsites can be found using the provided options,
+// an error is returned.
+func (ps *PlatformWebsiteService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlatformWebsite, error) {
 	var web []*PlatformWebsite
 
-	err := ps.client.post(ps.end, &web, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlatformWebsites")
 	}
@@ -81,7 +109,15 @@ func (ps *PlatformWebsiteService) Index(opts ...Option) ([]*PlatformWebsite, err
 // Provide the SetFilter functional option if you need to filter
 // which PlatformWebsites to count.
 func (ps *PlatformWebsiteService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlatformWebsites available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which PlatformWebsites to count.
+func (ps *PlatformWebsiteService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlatformWebsites")
 	}
@@ -92,7 +128,14 @@ func (ps *PlatformWebsiteService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlatformWebsite object.
 func (ps *PlatformWebsiteService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlatformWebsite object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PlatformWebsiteService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlatformWebsite fields")
 	}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,12 +12,12 @@ import (
 // PlayerPerspective describes the view or perspective of the player in a video game.
 // For more information visit: https://api-docs.igdb.com/#player-perspective
 type PlayerPerspective struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // PlayerPerspectiveService handles all the API calls for the IGDB PlayerPerspective endpoint.
@@ -26,14 +27,23 @@ type PlayerPerspectiveService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any PlayerPerspectives, an error is returned.
 func (ps *PlayerPerspectiveService) Get(id int, opts ...Option) (*PlayerPerspective, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PlayerPerspective identified by the provided
+// IGDB ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any
+// PlayerPerspectives, an error is returned.
+func (ps *PlayerPerspectiveService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PlayerPerspective, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var pp []*PlayerPerspective
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ps.client.post(ps.end, &pp, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &pp, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlayerPerspective with ID %v", id)
 	}
@@ -46,6 +56,16 @@ func (ps *PlayerPerspectiveService) Get(id int, opts ...Option) (*PlayerPerspect
 // Any ID that does not match a PlayerPerspective is ignored. If none of the IDs
 // match a PlayerPerspective, an error is returned.
 func (ps *PlayerPerspectiveService) List(ids []int, opts ...Option) ([]*PlayerPerspective, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PlayerPerspectives identified by the
+// provided list of IGDB IDs. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. Any ID that does not match a
+// PlayerPerspective is ignored. If none of the IDs match a PlayerPerspective,
+// an error is returned.
+func (ps *PlayerPerspectiveService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PlayerPerspective, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -59,7 +79,7 @@ func (ps *PlayerPerspectiveService) List(ids []int, opts ...Option) ([]*PlayerPe
 	var pp []*PlayerPerspective
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ps.client.post(ps.end, &pp, opts...)
+	err := ps.client.postWithContext(ctx, ps.end, &pp, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get PlayerPerspectives with IDs %v", ids)
 	}
@@ -68,12 +88,26 @@ func (ps *PlayerPerspectiveService) List(ids []int, opts ...Option) ([]*PlayerPe
 }
 
 // Index returns an index of PlayerPerspectives based solely on the provided functional
-// options used to sort, filter, and paginate the results. If no PlayerPerspectives can
-// be found using the provided options, an error is returned.
+// options used to sort, filter, and paginate the results. Unless otherwise
+// specified, every field of up to 500 PlayerPerspectives is retrieved so that calling
+// Index without any options returns the full list of PlayerPerspectives. If no PlayerPerspectives
+// can be found using the provided options, an error is returned.
 func (ps *PlayerPerspectiveService) Index(opts ...Option) ([]*PlayerPerspective, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PlayerPerspectives based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Unless otherwise specified, every field of up to 500
+// PlayerPerspectives is retrieved so that calling Index without any options
+// returns the full list of PlayerPerspectives. If no PlayerPerspectives can be
+// found using the provided options, an error is returned.
+func (ps *PlayerPerspectiveService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PlayerPerspective, error) {
 	var pp []*PlayerPerspective
 
-	err := ps.client.post(ps.end, &pp, opts...)
+	opts = withIndexDefaults(opts)
+	err := ps.client.postWithContext(ctx, ps.end, &pp, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of PlayerPerspectives")
 	}
@@ -85,7 +119,15 @@ func (ps *PlayerPerspectiveService) Index(opts ...Option) ([]*PlayerPerspective,
 // Provide the SetFilter functional option if you need to filter
 // which PlayerPerspectives to count.
 func (ps *PlayerPerspectiveService) Count(opts ...Option) (int, error) {
-	ct, err := ps.client.getCount(ps.end, opts...)
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PlayerPerspectives available in the
+// IGDB. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFilter functional option if you need to
+// filter which PlayerPerspectives to count.
+func (ps *PlayerPerspectiveService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count PlayerPerspectives")
 	}
@@ -96,7 +138,14 @@ func (ps *PlayerPerspectiveService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB PlayerPerspective object.
 func (ps *PlayerPerspectiveService) Fields() ([]string, error) {
-	f, err := ps.client.getFields(ps.end)
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PlayerPerspective object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PlayerPerspectiveService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get PlayerPerspective fields")
 	}
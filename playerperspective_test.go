@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestPlayerPerspectiveService_Get(t *testing.T) {
 			defer ts.Close()
 
 			pp, err := c.PlayerPerspectives.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestPlayerPerspectiveService_List(t *testing.T) {
 			defer ts.Close()
 
 			pp, err := c.PlayerPerspectives.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -140,7 +141,7 @@ func TestPlayerPerspectiveService_Index(t *testing.T) {
 			defer ts.Close()
 
 			pp, err := c.PlayerPerspectives.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -151,6 +152,44 @@ func TestPlayerPerspectiveService_Index(t *testing.T) {
 	}
 }
 
+func TestPlayerPerspectiveService_IndexDefaults(t *testing.T) {
+	var tests = []struct {
+		name    string
+		opts    []Option
+		wantQry []string
+	}{
+		{"No options", nil, []string{"fields *;", "limit 500;"}},
+		{"Custom fields", []Option{SetFields("name")}, []string{"fields name;", "limit 500;"}},
+		{"Custom limit", []Option{SetLimit(5)}, []string{"fields *;", "limit 5;"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				w.Write([]byte(`[{"id": 1, "name": "First person"}]`))
+			})
+			defer ts.Close()
+
+			_, err := c.PlayerPerspectives.Index(test.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.wantQry {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
 func TestPlayerPerspectiveService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -171,7 +210,7 @@ func TestPlayerPerspectiveService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.PlayerPerspectives.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +241,7 @@ func TestPlayerPerspectiveService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.PlayerPerspectives.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
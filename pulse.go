@@ -0,0 +1,172 @@
+package igdb
+
+import (
+	"context"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct Pulse -add-tags json -w
+
+// Pulse represents a single news article from the IGDB's news feed.
+// For more information visit: https://api-docs.igdb.com/#pulse
+type Pulse struct {
+	ID          int       `json:"id"`
+	Author      string    `json:"author"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Image       string    `json:"image"`
+	PublishedAt Timestamp `json:"published_at"`
+	PulseImage  int       `json:"pulse_image"`
+	PulseSource int       `json:"pulse_source"`
+	Summary     string    `json:"summary"`
+	Tags        []int     `json:"tags"`
+	Title       string    `json:"title"`
+	UID         string    `json:"uid"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	Videos      []string  `json:"videos"`
+	Website     int       `json:"website"`
+}
+
+// PulseService handles all the API calls for the IGDB Pulse endpoint.
+type PulseService service
+
+// Get returns a single Pulse identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any Pulses, an error is returned.
+func (ps *PulseService) Get(id int, opts ...Option) (*Pulse, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Pulse identified by the provided IGDB ID. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Pulses, an error is
+// returned.
+func (ps *PulseService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Pulse, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var pulse []*Pulse
+
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &pulse, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Pulse with ID %v", id)
+	}
+
+	return pulse[0], nil
+}
+
+// List returns a list of Pulses identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a Pulse is ignored. If none of the IDs
+// match a Pulse, an error is returned.
+func (ps *PulseService) List(ids []int, opts ...Option) ([]*Pulse, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Pulses identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Pulse is ignored. If none
+// of the IDs match a Pulse, an error is returned.
+func (ps *PulseService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Pulse, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var pulse []*Pulse
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.postWithContext(ctx, ps.end, &pulse, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Pulses with IDs %v", ids)
+	}
+
+	return pulse, nil
+}
+
+// Latest returns the n most recently published Pulses sorted by their
+// publication date in descending order. The value of n must be between 1
+// and 500. Provide functional options to filter the results. If no Pulses
+// are found, an error is returned.
+func (ps *PulseService) Latest(n int, opts ...Option) ([]*Pulse, error) {
+	var pulse []*Pulse
+
+	opts = append(withoutClauses(opts, "sort", "limit"), SetOrder("published_at", OrderDescending), SetLimit(n))
+	err := ps.client.post(ps.end, &pulse, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get latest %v Pulses", n)
+	}
+
+	return pulse, nil
+}
+
+// Index returns an index of Pulses based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no Pulses can
+// be found using the provided options, an error is returned.
+func (ps *PulseService) Index(opts ...Option) ([]*Pulse, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Pulses based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Pulses can be found using the provided options, an error is
+// returned.
+func (ps *PulseService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Pulse, error) {
+	var pulse []*Pulse
+
+	err := ps.client.postWithContext(ctx, ps.end, &pulse, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of Pulses")
+	}
+
+	return pulse, nil
+}
+
+// Count returns the number of Pulses available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which Pulses to count.
+func (ps *PulseService) Count(opts ...Option) (int, error) {
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Pulses available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Pulses to count.
+func (ps *PulseService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count Pulses")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB Pulse object.
+func (ps *PulseService) Fields() ([]string, error) {
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Pulse
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ps *PulseService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Pulse fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,297 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testPulseGet  string = "test_data/pulse_get.json"
+	testPulseList string = "test_data/pulse_list.json"
+)
+
+func TestPulseService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Pulse, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantPulse *Pulse
+		wantErr   error
+	}{
+		{"Valid response", testPulseGet, 382331, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 382331, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 382331, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pulse, err := c.Pulses.Get(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pulse, test.wantPulse) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pulse, test.wantPulse)
+			}
+		})
+	}
+}
+
+func TestPulseService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Pulse, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		ids        []int
+		opts       []Option
+		wantPulses []*Pulse
+		wantErr    error
+	}{
+		{"Valid response", testPulseList, []int{382331, 382330, 382329}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{382331, 382330, 382329}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{382331, 382330, 382329}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pulse, err := c.Pulses.List(test.ids, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pulse, test.wantPulses) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pulse, test.wantPulses)
+			}
+		})
+	}
+}
+
+func TestPulseService_Latest(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Pulse, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		n          int
+		opts       []Option
+		wantBody   []string
+		wantPulses []*Pulse
+		wantErr    error
+	}{
+		{"Valid response", testPulseList, 3, nil, []string{"sort published_at desc;", "limit 3;"}, init, nil},
+		{"Overridden options", testPulseList, 3, []Option{SetLimit(50), SetOrder("title", OrderAscending)}, []string{"sort published_at desc;", "limit 3;"}, init, nil},
+		{"Zero n", testFileEmpty, 0, nil, nil, nil, ErrOutOfRange},
+		{"Too large n", testFileEmpty, 501, nil, nil, nil, ErrOutOfRange},
+		{"Empty response", testFileEmpty, 3, nil, nil, nil, errInvalidJSON},
+		{"No results", testFileEmptyArray, 3, nil, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			pulse, err := c.Pulses.Latest(test.n, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pulse, test.wantPulses) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pulse, test.wantPulses)
+			}
+
+			for _, want := range test.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPulse_PublishedAt(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pulse []*Pulse
+	err = json.Unmarshal(f, &pulse)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2019, time.March, 31, 23, 0, 0, 0, time.UTC)
+	if !pulse[0].PublishedAt.Equal(want) {
+		t.Errorf("got: <%v>, want: <%v>", pulse[0].PublishedAt, want)
+	}
+}
+
+func TestPulseService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Pulse, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		file       string
+		opts       []Option
+		wantPulses []*Pulse
+		wantErr    error
+	}{
+		{"Valid response", testPulseList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pulse, err := c.Pulses.Index(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pulse, test.wantPulses) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pulse, test.wantPulses)
+			}
+		})
+	}
+}
+
+func TestPulseService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.Pulses.Count(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
+
+func TestPulseService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.Pulses.Fields()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package igdb
+
+import (
+	"context"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct PulseGroup -add-tags json -w
+
+// PulseGroup represents a collection of news articles, or Pulses,
+// grouped around a specific topic such as a Game.
+// For more information visit: https://api-docs.igdb.com/#pulse-group
+type PulseGroup struct {
+	ID          int       `json:"id"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Game        int       `json:"game"`
+	Name        string    `json:"name"`
+	PublishedAt Timestamp `json:"published_at"`
+	Pulses      []int     `json:"pulses"`
+	Tags        []int     `json:"tags"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// PulseGroupService handles all the API calls for the IGDB PulseGroup endpoint.
+type PulseGroupService service
+
+// Get returns a single PulseGroup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any PulseGroups, an error is returned.
+func (ps *PulseGroupService) Get(id int, opts ...Option) (*PulseGroup, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PulseGroup identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any PulseGroups,
+// an error is returned.
+func (ps *PulseGroupService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PulseGroup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var grp []*PulseGroup
+
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &grp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PulseGroup with ID %v", id)
+	}
+
+	return grp[0], nil
+}
+
+// List returns a list of PulseGroups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a PulseGroup is ignored. If none of the IDs
+// match a PulseGroup, an error is returned.
+func (ps *PulseGroupService) List(ids []int, opts ...Option) ([]*PulseGroup, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PulseGroups identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PulseGroup is ignored. If
+// none of the IDs match a PulseGroup, an error is returned.
+func (ps *PulseGroupService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PulseGroup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var grp []*PulseGroup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.postWithContext(ctx, ps.end, &grp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PulseGroups with IDs %v", ids)
+	}
+
+	return grp, nil
+}
+
+// ByGame returns the list of PulseGroups belonging to the Game identified by
+// the provided IGDB ID. Up to 500 PulseGroups are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no PulseGroups, an error is returned.
+func (ps *PulseGroupService) ByGame(gameID int, opts ...Option) ([]*PulseGroup, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var grp []*PulseGroup
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := ps.client.post(ps.end, &grp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PulseGroups with Game ID %v", gameID)
+	}
+
+	return grp, nil
+}
+
+// Index returns an index of PulseGroups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no PulseGroups can
+// be found using the provided options, an error is returned.
+func (ps *PulseGroupService) Index(opts ...Option) ([]*PulseGroup, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PulseGroups based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no PulseGroups can be found using the provided options, an error
+// is returned.
+func (ps *PulseGroupService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PulseGroup, error) {
+	var grp []*PulseGroup
+
+	err := ps.client.postWithContext(ctx, ps.end, &grp, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of PulseGroups")
+	}
+
+	return grp, nil
+}
+
+// Count returns the number of PulseGroups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which PulseGroups to count.
+func (ps *PulseGroupService) Count(opts ...Option) (int, error) {
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PulseGroups available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// PulseGroups to count.
+func (ps *PulseGroupService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count PulseGroups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB PulseGroup object.
+func (ps *PulseGroupService) Fields() ([]string, error) {
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB PulseGroup
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ps *PulseGroupService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get PulseGroup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,276 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	testPulseGroupGet  string = "test_data/pulsegroup_get.json"
+	testPulseGroupList string = "test_data/pulsegroup_list.json"
+)
+
+func TestPulseGroupService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGroupGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PulseGroup, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		id             int
+		opts           []Option
+		wantPulseGroup *PulseGroup
+		wantErr        error
+	}{
+		{"Valid response", testPulseGroupGet, 31442, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 31442, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 31442, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			grp, err := c.PulseGroups.Get(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(grp, test.wantPulseGroup) {
+				t.Errorf("got: <%v>, \nwant: <%v>", grp, test.wantPulseGroup)
+			}
+		})
+	}
+}
+
+func TestPulseGroupService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGroupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PulseGroup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name            string
+		file            string
+		ids             []int
+		opts            []Option
+		wantPulseGroups []*PulseGroup
+		wantErr         error
+	}{
+		{"Valid response", testPulseGroupList, []int{31442, 31441}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{31442, 31441}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{31442, 31441}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			grp, err := c.PulseGroups.List(test.ids, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(grp, test.wantPulseGroups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", grp, test.wantPulseGroups)
+			}
+		})
+	}
+}
+
+func TestPulseGroupService_ByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGroupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PulseGroup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name            string
+		file            string
+		id              int
+		opts            []Option
+		wantLim         string
+		wantPulseGroups []*PulseGroup
+		wantErr         error
+	}{
+		{"Valid response", testPulseGroupList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testPulseGroupList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			grp, err := c.PulseGroups.ByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(grp, test.wantPulseGroups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", grp, test.wantPulseGroups)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
+func TestPulseGroupService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testPulseGroupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PulseGroup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		file            string
+		opts            []Option
+		wantPulseGroups []*PulseGroup
+		wantErr         error
+	}{
+		{"Valid response", testPulseGroupList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			grp, err := c.PulseGroups.Index(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(grp, test.wantPulseGroups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", grp, test.wantPulseGroups)
+			}
+		})
+	}
+}
+
+func TestPulseGroupService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.PulseGroups.Count(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
+
+func TestPulseGroupService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.PulseGroups.Fields()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package igdb
+
+import (
+	"context"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct PulseSource -add-tags json -w
+
+// PulseSource represents a news source, such as a website or publication,
+// that Pulses are retrieved from.
+// For more information visit: https://api-docs.igdb.com/#pulse-source
+type PulseSource struct {
+	ID   int    `json:"id"`
+	Game int    `json:"game"`
+	Name string `json:"name"`
+	Page int    `json:"page"`
+}
+
+// PulseSourceService handles all the API calls for the IGDB PulseSource endpoint.
+type PulseSourceService service
+
+// Get returns a single PulseSource identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any PulseSources, an error is returned.
+func (ps *PulseSourceService) Get(id int, opts ...Option) (*PulseSource, error) {
+	return ps.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single PulseSource identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any PulseSources,
+// an error is returned.
+func (ps *PulseSourceService) GetWithContext(ctx context.Context, id int, opts ...Option) (*PulseSource, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var src []*PulseSource
+
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.postWithContext(ctx, ps.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PulseSource with ID %v", id)
+	}
+
+	return src[0], nil
+}
+
+// List returns a list of PulseSources identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a PulseSource is ignored. If none of the IDs
+// match a PulseSource, an error is returned.
+func (ps *PulseSourceService) List(ids []int, opts ...Option) ([]*PulseSource, error) {
+	return ps.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of PulseSources identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a PulseSource is ignored. If
+// none of the IDs match a PulseSource, an error is returned.
+func (ps *PulseSourceService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*PulseSource, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var src []*PulseSource
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.postWithContext(ctx, ps.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PulseSources with IDs %v", ids)
+	}
+
+	return src, nil
+}
+
+// Index returns an index of PulseSources based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no PulseSources can
+// be found using the provided options, an error is returned.
+func (ps *PulseSourceService) Index(opts ...Option) ([]*PulseSource, error) {
+	return ps.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of PulseSources based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no PulseSources can be found using the provided options, an
+// error is returned.
+func (ps *PulseSourceService) IndexWithContext(ctx context.Context, opts ...Option) ([]*PulseSource, error) {
+	var src []*PulseSource
+
+	err := ps.client.postWithContext(ctx, ps.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of PulseSources")
+	}
+
+	return src, nil
+}
+
+// Count returns the number of PulseSources available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which PulseSources to count.
+func (ps *PulseSourceService) Count(opts ...Option) (int, error) {
+	return ps.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of PulseSources available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which PulseSources to count.
+func (ps *PulseSourceService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ps.client.getCountWithContext(ctx, ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count PulseSources")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB PulseSource object.
+func (ps *PulseSourceService) Fields() ([]string, error) {
+	return ps.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// PulseSource object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (ps *PulseSourceService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ps.client.getFieldsWithContext(ctx, ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get PulseSource fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,200 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RequestsPerSecond is the number of requests the IGDB allows each
+// client to make every second.
+const RequestsPerSecond = 4
+
+// RateLimitStatus describes the requests a Client has made against the IGDB
+// rate limits. The IGDB does not report any of these values itself, so they
+// only account for requests made by the Client they were retrieved from.
+type RateLimitStatus struct {
+	// RequestsThisSecond is the number of requests made during the current second.
+	RequestsThisSecond int
+	// RequestsThisMonth is the number of requests made during the current month.
+	RequestsThisMonth int
+	// SecondsUntilReset is the number of seconds until the monthly count is reset.
+	SecondsUntilReset int
+	// MonthlyLimit is the number of requests allowed each month. The IGDB does
+	// not currently limit monthly requests, so it is zero unless the limit is known.
+	MonthlyLimit int
+}
+
+// Remaining returns the number of requests that can still be made during the
+// current second without exceeding the IGDB rate limit.
+func (s RateLimitStatus) Remaining() int {
+	if s.RequestsThisSecond >= RequestsPerSecond {
+		return 0
+	}
+
+	return RequestsPerSecond - s.RequestsThisSecond
+}
+
+// rateCounter counts the requests made during the current second and the
+// current month. Each counter packs its window into the upper 32 bits and its
+// count into the lower 32 bits so both can be updated in a single atomic
+// operation.
+type rateCounter struct {
+	second uint64
+	month  uint64
+}
+
+// record counts a request made at the provided time.
+func (r *rateCounter) record(now time.Time) {
+	increment(&r.second, uint64(now.Unix()))
+	increment(&r.month, monthIndex(now))
+}
+
+// status returns the RateLimitStatus of the counted requests at the provided time.
+func (r *rateCounter) status(now time.Time) RateLimitStatus {
+	next := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location())
+
+	return RateLimitStatus{
+		RequestsThisSecond: count(&r.second, uint64(now.Unix())),
+		RequestsThisMonth:  count(&r.month, monthIndex(now)),
+		SecondsUntilReset:  int(next.Sub(now).Seconds()),
+	}
+}
+
+// increment atomically increments the count packed in the provided counter,
+// restarting the count if the counter belongs to a window other than the
+// provided window.
+func increment(counter *uint64, window uint64) {
+	for {
+		old := atomic.LoadUint64(counter)
+
+		v := window<<32 | 1
+		if old>>32 == window {
+			v = old + 1
+		}
+
+		if atomic.CompareAndSwapUint64(counter, old, v) {
+			return
+		}
+	}
+}
+
+// count returns the count packed in the provided counter if the counter
+// belongs to the provided window. Otherwise, zero is returned.
+func count(counter *uint64, window uint64) int {
+	v := atomic.LoadUint64(counter)
+	if v>>32 != window {
+		return 0
+	}
+
+	return int(v & (1<<32 - 1))
+}
+
+// monthIndex returns the number of months between year zero and the provided time.
+func monthIndex(t time.Time) uint64 {
+	return uint64(t.Year()*12 + int(t.Month()) - 1)
+}
+
+// RateLimitStatus returns the status of the requests the Client has made
+// against the IGDB rate limits. This is useful for backing off before the
+// rate limit is reached rather than after.
+func (c *Client) RateLimitStatus() RateLimitStatus {
+	return c.rate.status(time.Now())
+}
+
+// limiter throttles requests to a maximum number of requests per period. The
+// requests are spread evenly across the period while still allowing a burst
+// of up to the maximum number of requests at once.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration // interval is the time each request reserves.
+	burst    time.Duration // burst is how far ahead of schedule a request may be sent.
+	next     time.Time     // next is the time the next request is scheduled for.
+}
+
+// newLimiter returns a limiter allowing the provided number of requests per
+// the provided period.
+func newLimiter(requests int, per time.Duration) *limiter {
+	interval := per / time.Duration(requests)
+
+	return &limiter{
+		interval: interval,
+		burst:    interval * time.Duration(requests-1),
+	}
+}
+
+// wait blocks until a request may be sent without exceeding the limit or until
+// the provided context is done. If the context is done first, its error is
+// returned.
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next.Add(-l.burst)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	d := at.Sub(now)
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause prevents any request from being sent for the provided duration.
+func (l *limiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	resume := time.Now().Add(d + l.burst)
+	if resume.After(l.next) {
+		l.next = resume
+	}
+}
+
+// retryAfter returns the duration specified by the Retry-After header of the
+// provided response. The header may contain either a number of seconds or an
+// HTTP date. If the header is missing or malformed, zero is returned.
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+
+	if sec, err := strconv.Atoi(v); err == nil && sec > 0 {
+		return time.Duration(sec) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
+
+// SetRateLimit throttles the Client to at most the provided number of requests
+// per the provided period (e.g. 4 requests per second). Requests exceeding the
+// limit block until they can be sent. If the IGDB responds with a Too Many
+// Requests status and a Retry-After header, subsequent requests wait for the
+// specified duration. The limit is safe for concurrent use. A non-positive
+// number of requests or period disables the limit, which is the default.
+// SetRateLimit should be called before the Client is used.
+func (c *Client) SetRateLimit(requests int, per time.Duration) {
+	if requests <= 0 || per <= 0 {
+		c.limit = nil
+		return
+	}
+
+	c.limit = newLimiter(requests, per)
+}
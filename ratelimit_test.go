@@ -0,0 +1,267 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRateCounter(t *testing.T) {
+	start := time.Date(2020, time.January, 31, 23, 59, 58, 0, time.UTC)
+
+	var tests = []struct {
+		name       string
+		requests   []time.Duration
+		at         time.Duration
+		wantStatus RateLimitStatus
+	}{
+		{
+			"No requests",
+			nil,
+			0,
+			RateLimitStatus{SecondsUntilReset: 2},
+		},
+		{
+			"Same second",
+			[]time.Duration{0, 100 * time.Millisecond, 900 * time.Millisecond},
+			900 * time.Millisecond,
+			RateLimitStatus{RequestsThisSecond: 3, RequestsThisMonth: 3, SecondsUntilReset: 1},
+		},
+		{
+			"Next second",
+			[]time.Duration{0, 500 * time.Millisecond, time.Second},
+			time.Second,
+			RateLimitStatus{RequestsThisSecond: 1, RequestsThisMonth: 3, SecondsUntilReset: 1},
+		},
+		{
+			"Stale second",
+			[]time.Duration{0, 500 * time.Millisecond},
+			time.Second,
+			RateLimitStatus{RequestsThisSecond: 0, RequestsThisMonth: 2, SecondsUntilReset: 1},
+		},
+		{
+			"Next month",
+			[]time.Duration{0, time.Second, 2 * time.Second},
+			2 * time.Second,
+			RateLimitStatus{RequestsThisSecond: 1, RequestsThisMonth: 1, SecondsUntilReset: 29 * 24 * 60 * 60},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var r rateCounter
+			for _, d := range test.requests {
+				r.record(start.Add(d))
+			}
+
+			status := r.status(start.Add(test.at))
+			if status != test.wantStatus {
+				t.Errorf("got: <%v>, want: <%v>", status, test.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRateCounter_Concurrent(t *testing.T) {
+	now := time.Now()
+
+	var r rateCounter
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.record(now)
+		}()
+	}
+	wg.Wait()
+
+	status := r.status(now)
+	if status.RequestsThisSecond != 100 || status.RequestsThisMonth != 100 {
+		t.Errorf("got: <%v>, want: <%v> requests", status, 100)
+	}
+}
+
+func TestRateLimitStatus_Remaining(t *testing.T) {
+	var tests = []struct {
+		name    string
+		reqs    int
+		wantRem int
+	}{
+		{"No requests", 0, RequestsPerSecond},
+		{"Some requests", 1, RequestsPerSecond - 1},
+		{"Limit reached", RequestsPerSecond, 0},
+		{"Limit exceeded", RequestsPerSecond + 2, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rem := RateLimitStatus{RequestsThisSecond: test.reqs}.Remaining()
+			if rem != test.wantRem {
+				t.Errorf("got: <%v>, want: <%v>", rem, test.wantRem)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimitStatus(t *testing.T) {
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": 1}]`))
+	})
+	defer ts.Close()
+
+	var g []*Game
+	for i := 0; i < 3; i++ {
+		if err := c.post(EndpointGame, &g); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status := c.RateLimitStatus()
+	if status.RequestsThisMonth != 3 {
+		t.Errorf("got: <%v>, want: <%v>", status.RequestsThisMonth, 3)
+	}
+
+	if status.SecondsUntilReset <= 0 {
+		t.Errorf("got: <%v>, want positive seconds until reset", status.SecondsUntilReset)
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	var tests = []struct {
+		name     string
+		requests int
+		per      time.Duration
+		calls    int
+		wantMin  time.Duration
+		wantMax  time.Duration
+	}{
+		{"Within burst", 4, 400 * time.Millisecond, 4, 0, 50 * time.Millisecond},
+		{"Exceeds burst", 4, 400 * time.Millisecond, 6, 200 * time.Millisecond, 350 * time.Millisecond},
+		{"Single request per period", 1, 50 * time.Millisecond, 3, 100 * time.Millisecond, 200 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := newLimiter(test.requests, test.per)
+
+			start := time.Now()
+			var wg sync.WaitGroup
+			for i := 0; i < test.calls; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := l.wait(context.Background()); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			elapsed := time.Since(start)
+			if elapsed < test.wantMin || elapsed > test.wantMax {
+				t.Errorf("got: <%v>, want between <%v> and <%v>", elapsed, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
+
+func TestLimiter_WaitCanceled(t *testing.T) {
+	l := newLimiter(1, time.Hour)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := l.wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+}
+
+func TestLimiter_Pause(t *testing.T) {
+	l := newLimiter(4, time.Second)
+	l.pause(100 * time.Millisecond)
+
+	start := time.Now()
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	elapsed := time.Since(start)
+	if elapsed < 90*time.Millisecond || elapsed > 250*time.Millisecond {
+		t.Errorf("got: <%v>, want about <%v>", elapsed, 100*time.Millisecond)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var tests = []struct {
+		name    string
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{"Seconds", "3", 3 * time.Second, 3 * time.Second},
+		{"HTTP date", time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), 8 * time.Second, 10 * time.Second},
+		{"Missing", "", 0, 0},
+		{"Malformed", "soon", 0, 0},
+		{"Negative", "-5", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+
+			d := retryAfter(resp)
+			if d < test.wantMin || d > test.wantMax {
+				t.Errorf("got: <%v>, want between <%v> and <%v>", d, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
+
+func TestClient_SetRateLimit(t *testing.T) {
+	var calls int
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", strconv.Itoa(1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`[{"id": 1}]`))
+	})
+	defer ts.Close()
+
+	c.SetRateLimit(100, time.Second)
+
+	var g []*Game
+	err := c.post(EndpointGame, &g)
+	if !errors.Is(err, ErrManyRequests) {
+		t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), ErrManyRequests)
+	}
+
+	start := time.Now()
+	if err := c.post(EndpointGame, &g); err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("got: <%v>, want at least <%v>", elapsed, time.Second)
+	}
+
+	c.SetRateLimit(0, time.Second)
+	if c.limit != nil {
+		t.Errorf("got: <%v>, want disabled limiter", c.limit)
+	}
+}
@@ -0,0 +1,207 @@
+package igdb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RequestStat describes a single API call made by a Client.
+type RequestStat struct {
+	// Endpoint is the endpoint called, relative to the root URL
+	// (e.g. "games/" or "games/count").
+	Endpoint string
+	// Status is the HTTP status code of the final response, or zero if no
+	// response was received.
+	Status int
+	// Duration is the time taken by the call, including any retries.
+	Duration time.Duration
+	// Size is the number of bytes in the body of the final response.
+	Size int64
+	// Retries is the number of times the request was retried.
+	Retries int
+	// Cached is true if the results were read from the Client's Cache.
+	Cached bool
+	// Err is the error returned by the call, if any.
+	Err error
+}
+
+// Recorder records statistics about the API calls made by a Client, such as
+// for exporting metrics. Implementations must be safe for concurrent use.
+type Recorder interface {
+	// Record is called once for each API call after any retries
+	// have been resolved.
+	Record(stat RequestStat)
+}
+
+// NopRecorder is a Recorder that discards every RequestStat.
+type NopRecorder struct{}
+
+// Record discards the provided RequestStat.
+func (NopRecorder) Record(RequestStat) {}
+
+// SetRecorder configures the Client to report a RequestStat to the provided
+// Recorder for each of its API calls. A nil Recorder discards the statistics,
+// which is the default. Unlike OnResponse hooks, which receive each raw HTTP
+// response, a Recorder is called once per call with its aggregated outcome.
+// SetRecorder should be called before the Client is used.
+func (c *Client) SetRecorder(r Recorder) {
+	if _, ok := r.(NopRecorder); ok {
+		r = nil
+	}
+
+	c.recorder = r
+}
+
+// RecorderStats is a snapshot of the statistics held by a MemoryRecorder.
+type RecorderStats struct {
+	// Requests is the number of API calls recorded.
+	Requests int
+	// Errors is the number of API calls that returned an error,
+	// excluding calls without results.
+	Errors int
+	// Cached is the number of API calls answered from a Cache.
+	Cached int
+	// Retries is the total number of retried requests.
+	Retries int
+	// Bytes is the total size of the response bodies.
+	Bytes int64
+	// Duration is the total duration of the API calls.
+	Duration time.Duration
+	// Statuses is the number of API calls per HTTP status code.
+	Statuses map[int]int
+	// Endpoints is the number of API calls per endpoint.
+	Endpoints map[string]int
+}
+
+// MemoryRecorder is a Recorder that keeps running totals of the statistics
+// it records in memory. MemoryRecorder is safe for concurrent use. The zero
+// value is ready to use.
+type MemoryRecorder struct {
+	mu    sync.Mutex
+	stats RecorderStats
+}
+
+// Record adds the provided RequestStat to the totals.
+func (m *MemoryRecorder) Record(stat RequestStat) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stats.Statuses == nil {
+		m.stats.Statuses = make(map[int]int)
+		m.stats.Endpoints = make(map[string]int)
+	}
+
+	m.stats.Requests++
+	if stat.Err != nil && !errors.Is(stat.Err, ErrNoResults) {
+		m.stats.Errors++
+	}
+	if stat.Cached {
+		m.stats.Cached++
+	}
+	m.stats.Retries += stat.Retries
+	m.stats.Bytes += stat.Size
+	m.stats.Duration += stat.Duration
+	m.stats.Statuses[stat.Status]++
+	m.stats.Endpoints[stat.Endpoint]++
+}
+
+// Stats returns a snapshot of the totals recorded so far.
+func (m *MemoryRecorder) Stats() RecorderStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.stats
+	s.Statuses = make(map[int]int, len(m.stats.Statuses))
+	for k, v := range m.stats.Statuses {
+		s.Statuses[k] = v
+	}
+	s.Endpoints = make(map[string]int, len(m.stats.Endpoints))
+	for k, v := range m.stats.Endpoints {
+		s.Endpoints[k] = v
+	}
+
+	return s
+}
+
+// Reset discards the totals recorded so far.
+func (m *MemoryRecorder) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stats = RecorderStats{}
+}
+
+// requestTrace collects the details of a single API call that are only
+// known deep inside the send path.
+type requestTrace struct {
+	retries int
+	size    int64
+	cached  bool
+}
+
+// traceKey is the context key of the requestTrace of an API call.
+type traceKey struct{}
+
+// traceFrom returns the requestTrace stored in the provided context. If no
+// requestTrace is stored, a throwaway requestTrace is returned.
+func traceFrom(ctx context.Context) *requestTrace {
+	if t, ok := ctx.Value(traceKey{}).(*requestTrace); ok {
+		return t
+	}
+
+	return &requestTrace{}
+}
+
+// sendFunc sends a request and stores the response in the value pointed
+// to by result.
+type sendFunc func(req *http.Request, result interface{}) (*Response, error)
+
+// recorded returns the provided sendFunc, reporting a RequestStat for each
+// call to the Client's Recorder if one is set.
+func (c *Client) recorded(send sendFunc) sendFunc {
+	if c.recorder == nil {
+		return send
+	}
+
+	return func(req *http.Request, result interface{}) (*Response, error) {
+		trace := &requestTrace{}
+		req = req.WithContext(context.WithValue(req.Context(), traceKey{}, trace))
+
+		start := time.Now()
+		r, err := send(req, result)
+
+		stat := RequestStat{
+			Endpoint: strings.TrimPrefix(req.URL.String(), c.rootURL),
+			Duration: time.Since(start),
+			Size:     trace.size,
+			Retries:  trace.retries,
+			Cached:   trace.cached,
+			Err:      err,
+		}
+		if r != nil {
+			stat.Status = r.Status
+		}
+
+		c.recorder.Record(stat)
+		return r, err
+	}
+}
+
+// countingBody is a response body that counts the bytes read from it.
+type countingBody struct {
+	io.ReadCloser
+	n *int64
+}
+
+// Read reads from the underlying body and counts the bytes read.
+func (cb countingBody) Read(p []byte) (int, error) {
+	n, err := cb.ReadCloser.Read(p)
+	*cb.n += int64(n)
+	return n, err
+}
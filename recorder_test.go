@@ -0,0 +1,205 @@
+package igdb
+
+import (
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// testRecorder is a Recorder that stores every RequestStat it records.
+type testRecorder struct {
+	mu    sync.Mutex
+	stats []RequestStat
+}
+
+func (r *testRecorder) Record(stat RequestStat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stats = append(r.stats, stat)
+}
+
+func TestClient_SetRecorder(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int
+		resp      string
+		retries   int
+		cache     bool
+		calls     int
+		wantStats []RequestStat
+		wantErr   error
+	}{
+		{
+			"Status OK",
+			[]int{http.StatusOK},
+			"[" + testResult + "]",
+			0,
+			false,
+			1,
+			[]RequestStat{{Endpoint: testEndpoint, Status: http.StatusOK, Size: 30}},
+			nil,
+		},
+		{
+			"Empty results",
+			[]int{http.StatusOK},
+			"[]",
+			0,
+			false,
+			1,
+			[]RequestStat{{Endpoint: testEndpoint, Status: http.StatusOK, Size: 2}},
+			ErrNoResults,
+		},
+		{
+			"Status Bad Request",
+			[]int{http.StatusBadRequest},
+			`[{"title": "Syntax Error"}]`,
+			0,
+			false,
+			1,
+			[]RequestStat{{Endpoint: testEndpoint, Status: http.StatusBadRequest}},
+			ErrBadRequest,
+		},
+		{
+			"Retried",
+			[]int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK},
+			"[" + testResult + "]",
+			2,
+			false,
+			1,
+			[]RequestStat{{Endpoint: testEndpoint, Status: http.StatusOK, Size: 30, Retries: 2}},
+			nil,
+		},
+		{
+			"Cached",
+			[]int{http.StatusOK},
+			"[" + testResult + "]",
+			0,
+			true,
+			2,
+			[]RequestStat{
+				{Endpoint: testEndpoint, Status: http.StatusOK, Size: 30},
+				{Endpoint: testEndpoint, Status: http.StatusOK, Size: 30, Cached: true},
+			},
+			nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := test.statuses[len(test.statuses)-1]
+				if reqs < len(test.statuses) {
+					status = test.statuses[reqs]
+				}
+				reqs++
+
+				w.WriteHeader(status)
+				if status == http.StatusOK || status == http.StatusBadRequest {
+					w.Write([]byte(test.resp))
+				}
+			})
+			defer ts.Close()
+
+			rec := &testRecorder{}
+			c.SetRecorder(rec)
+			c.SetRetry(test.retries, time.Millisecond)
+			if test.cache {
+				c.SetCache(NewLRUCache(1), time.Minute)
+			}
+
+			for i := 0; i < test.calls; i++ {
+				var res []testResultPlaceholder
+
+				err := c.post(testEndpoint, &res)
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+				}
+			}
+
+			if len(rec.stats) != len(test.wantStats) {
+				t.Fatalf("got: <%v> stats, want: <%v> stats", len(rec.stats), len(test.wantStats))
+			}
+
+			for i, got := range rec.stats {
+				if !errors.Is(got.Err, test.wantErr) {
+					t.Errorf("got: <%v>, want: <%v>", got.Err, test.wantErr)
+				}
+
+				if got.Duration <= 0 {
+					t.Errorf("got: <%v>, want positive duration", got.Duration)
+				}
+
+				got.Err, got.Duration = nil, 0
+				if !reflect.DeepEqual(got, test.wantStats[i]) {
+					t.Errorf("got: <%+v>, want: <%+v>", got, test.wantStats[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClient_SetRecorder_Disabled(t *testing.T) {
+	c := NewClient(testClientID, testToken, nil)
+
+	c.SetRecorder(&MemoryRecorder{})
+	if c.recorder == nil {
+		t.Errorf("got: <nil>, want: <%T>", &MemoryRecorder{})
+	}
+
+	c.SetRecorder(NopRecorder{})
+	if c.recorder != nil {
+		t.Errorf("got: <%v>, want: <nil>", c.recorder)
+	}
+
+	c.SetRecorder(nil)
+	if c.recorder != nil {
+		t.Errorf("got: <%v>, want: <nil>", c.recorder)
+	}
+}
+
+func TestMemoryRecorder(t *testing.T) {
+	var m MemoryRecorder
+
+	stats := []RequestStat{
+		{Endpoint: "games/", Status: http.StatusOK, Duration: time.Second, Size: 100},
+		{Endpoint: "games/", Status: http.StatusOK, Duration: time.Second, Size: 2, Err: ErrNoResults},
+		{Endpoint: "games/", Status: http.StatusOK, Duration: time.Millisecond, Size: 100, Cached: true},
+		{Endpoint: "genres/", Status: http.StatusInternalServerError, Duration: time.Second, Retries: 3, Err: ErrInternalError},
+		{Endpoint: "genres/", Duration: time.Second, Retries: 1, Err: errors.New("connection refused")},
+	}
+	for _, s := range stats {
+		m.Record(s)
+	}
+
+	want := RecorderStats{
+		Requests:  5,
+		Errors:    2,
+		Cached:    1,
+		Retries:   4,
+		Bytes:     202,
+		Duration:  4*time.Second + time.Millisecond,
+		Statuses:  map[int]int{http.StatusOK: 3, http.StatusInternalServerError: 1, 0: 1},
+		Endpoints: map[string]int{"games/": 3, "genres/": 2},
+	}
+
+	got := m.Stats()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%+v>, want: <%+v>", got, want)
+	}
+
+	// The snapshot must not be affected by later changes.
+	got.Statuses[http.StatusOK] = 99
+	if m.Stats().Statuses[http.StatusOK] != 3 {
+		t.Errorf("got: <%v>, want: <%v>", m.Stats().Statuses[http.StatusOK], 3)
+	}
+
+	m.Reset()
+	if got := m.Stats(); got.Requests != 0 || len(got.Statuses) != 0 {
+		t.Errorf("got: <%+v>, want empty stats", got)
+	}
+}
@@ -1,9 +1,11 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
+	"time"
 )
 
 //go:generate gomodifytags -file $GOFILE -struct ReleaseDate -add-tags json -w
@@ -14,14 +16,15 @@ import (
 type ReleaseDate struct {
 	ID        int            `json:"id"`
 	Category  DateCategory   `json:"category"`
-	CreatedAt int            `json:"created_at"`
-	Date      int            `json:"date"`
+	CreatedAt Timestamp      `json:"created_at"`
+	Date      Timestamp      `json:"date"`
 	Game      int            `json:"game"`
 	Human     string         `json:"human"`
 	M         int            `json:"m"`
 	Platform  int            `json:"platform"`
 	Region    RegionCategory `json:"region"`
-	UpdatedAt int            `json:"updated_at"`
+	Status    int            `json:"status"`
+	UpdatedAt Timestamp      `json:"updated_at"`
 	Y         int            `json:"y"`
 }
 
@@ -64,14 +67,23 @@ type ReleaseDateService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any ReleaseDates, an error is returned.
 func (rs *ReleaseDateService) Get(id int, opts ...Option) (*ReleaseDate, error) {
+	return rs.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single ReleaseDate identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any ReleaseDates,
+// an error is returned.
+func (rs *ReleaseDateService) GetWithContext(ctx context.Context, id int, opts ...Option) (*ReleaseDate, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var date []*ReleaseDate
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := rs.client.post(rs.end, &date, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := rs.client.postWithContext(ctx, rs.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get ReleaseDate with ID %v", id)
 	}
@@ -84,6 +96,15 @@ func (rs *ReleaseDateService) Get(id int, opts ...Option) (*ReleaseDate, error)
 // Any ID that does not match a ReleaseDate is ignored. If none of the IDs
 // match a ReleaseDate, an error is returned.
 func (rs *ReleaseDateService) List(ids []int, opts ...Option) ([]*ReleaseDate, error) {
+	return rs.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of ReleaseDates identified by the provided
+// list of IGDB IDs. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a ReleaseDate is ignored. If
+// none of the IDs match a ReleaseDate, an error is returned.
+func (rs *ReleaseDateService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*ReleaseDate, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -97,7 +118,7 @@ func (rs *ReleaseDateService) List(ids []int, opts ...Option) ([]*ReleaseDate, e
 	var date []*ReleaseDate
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := rs.client.post(rs.end, &date, opts...)
+	err := rs.client.postWithContext(ctx, rs.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get ReleaseDates with IDs %v", ids)
 	}
@@ -105,13 +126,67 @@ func (rs *ReleaseDateService) List(ids []int, opts ...Option) ([]*ReleaseDate, e
 	return date, nil
 }
 
+// ListByGame returns the list of ReleaseDates belonging to the Game identified by
+// the provided IGDB ID. Up to 500 ReleaseDates are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no ReleaseDates, an error is returned.
+func (rs *ReleaseDateService) ListByGame(gameID int, opts ...Option) ([]*ReleaseDate, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var date []*ReleaseDate
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := rs.client.post(rs.end, &date, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ReleaseDates with Game ID %v", gameID)
+	}
+
+	return date, nil
+}
+
+// UpcomingByPlatform returns the list of ReleaseDates for the Platform
+// identified by the provided IGDB ID that have yet to occur. The ReleaseDates
+// are sorted by date in ascending order unless another order is provided.
+// If the Platform has no upcoming ReleaseDates, an error is returned.
+func (rs *ReleaseDateService) UpcomingByPlatform(platformID int, opts ...Option) ([]*ReleaseDate, error) {
+	if platformID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var date []*ReleaseDate
+
+	opts = withDefaults(opts, SetOrder("date", OrderAscending))
+	opts = append(opts,
+		SetFilter("platform", OpEquals, strconv.Itoa(platformID)),
+		SetFilter("date", OpGreaterThan, TimestampFromTime(time.Now())),
+	)
+	err := rs.client.post(rs.end, &date, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get upcoming ReleaseDates with Platform ID %v", platformID)
+	}
+
+	return date, nil
+}
+
 // Index returns an index of ReleaseDates based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no ReleaseDates can
 // be found using the provided options, an error is returned.
 func (rs *ReleaseDateService) Index(opts ...Option) ([]*ReleaseDate, error) {
+	return rs.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of ReleaseDates based solely on the
+// provided functional options used to sort, filter, and paginate the results.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. If no ReleaseDates can be found using the provided options, an
+// error is returned.
+func (rs *ReleaseDateService) IndexWithContext(ctx context.Context, opts ...Option) ([]*ReleaseDate, error) {
 	var date []*ReleaseDate
 
-	err := rs.client.post(rs.end, &date, opts...)
+	err := rs.client.postWithContext(ctx, rs.end, &date, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of ReleaseDates")
 	}
@@ -123,7 +198,15 @@ func (rs *ReleaseDateService) Index(opts ...Option) ([]*ReleaseDate, error) {
 // Provide the SetFilter functional option if you need to filter
 // which ReleaseDates to count.
 func (rs *ReleaseDateService) Count(opts ...Option) (int, error) {
-	ct, err := rs.client.getCount(rs.end, opts...)
+	return rs.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of ReleaseDates available in the IGDB.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFilter functional option if you need to filter
+// which ReleaseDates to count.
+func (rs *ReleaseDateService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := rs.client.getCountWithContext(ctx, rs.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count ReleaseDates")
 	}
@@ -134,7 +217,14 @@ func (rs *ReleaseDateService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB ReleaseDate object.
 func (rs *ReleaseDateService) Fields() ([]string, error) {
-	f, err := rs.client.getFields(rs.end)
+	return rs.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB
+// ReleaseDate object. The request is canceled if the provided context is
+// canceled or its deadline is exceeded.
+func (rs *ReleaseDateService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := rs.client.getFieldsWithContext(ctx, rs.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get ReleaseDate fields")
 	}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -50,7 +51,7 @@ func TestReleaseDateService_Get(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.ReleaseDates.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -97,7 +98,7 @@ func TestReleaseDateService_List(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.ReleaseDates.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -108,6 +109,68 @@ func TestReleaseDateService_List(t *testing.T) {
 	}
 }
 
+func TestReleaseDateService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testReleaseDateList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*ReleaseDate, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name             string
+		file             string
+		id               int
+		opts             []Option
+		wantLim          string
+		wantReleaseDates []*ReleaseDate
+		wantErr          error
+	}{
+		{"Valid response", testReleaseDateList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testReleaseDateList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			date, err := c.ReleaseDates.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(date, test.wantReleaseDates) {
+				t.Errorf("got: <%v>, \nwant: <%v>", date, test.wantReleaseDates)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
 func TestReleaseDateService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testReleaseDateList)
 	if err != nil {
@@ -141,7 +204,7 @@ func TestReleaseDateService_Index(t *testing.T) {
 			defer ts.Close()
 
 			date, err := c.ReleaseDates.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -172,7 +235,7 @@ func TestReleaseDateService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.ReleaseDates.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -203,7 +266,7 @@ func TestReleaseDateService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.ReleaseDates.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -213,3 +276,67 @@ func TestReleaseDateService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseDateService_UpcomingByPlatform(t *testing.T) {
+	f, err := ioutil.ReadFile(testReleaseDateList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*ReleaseDate, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name             string
+		file             string
+		id               int
+		opts             []Option
+		wantBody         []string
+		wantReleaseDates []*ReleaseDate
+		wantErr          error
+	}{
+		{"Valid response", testReleaseDateList, 48, nil, []string{"platform = 48", "date > ", "sort date asc;"}, init, nil},
+		{"Custom order", testReleaseDateList, 48, []Option{SetOrder("date", OrderDescending)}, []string{"sort date desc;"}, init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 48, nil, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 48, []Option{SetOffset(-99999)}, nil, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			date, err := c.ReleaseDates.UpcomingByPlatform(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(date, test.wantReleaseDates) {
+				t.Errorf("got: <%v>, \nwant: <%v>", date, test.wantReleaseDates)
+			}
+
+			for _, want := range test.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
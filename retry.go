@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxBackoffShift caps the exponent of the backoff between retries to
+// prevent the delay from overflowing.
+const maxBackoffShift = 16
+
+// retryPolicy configures how failed requests are retried.
+type retryPolicy struct {
+	attempts  int
+	baseDelay time.Duration
+}
+
+// SetRetry configures the Client to retry a failed request up to the provided
+// number of attempts. Only network errors, Too Many Requests statuses, and
+// server error statuses are retried. Other client error statuses, such as
+// those caused by an invalid query, are never retried. The delay before each
+// retry grows exponentially from the provided base delay with random jitter.
+// If the IGDB specifies a longer delay using the Retry-After header, that delay
+// is used instead. Zero attempts disables retries, which is the default.
+// SetRetry should be called before the Client is used.
+func (c *Client) SetRetry(attempts int, baseDelay time.Duration) {
+	if attempts < 0 {
+		attempts = 0
+	}
+
+	c.retry = retryPolicy{attempts: attempts, baseDelay: baseDelay}
+}
+
+// shouldRetry reports whether a request resulting in the provided response
+// and error should be retried.
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before the provided retry attempt, starting at
+// zero. The delay doubles with each attempt and is jittered between half and
+// all of its value.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	if attempt > maxBackoffShift {
+		attempt = maxBackoffShift
+	}
+
+	d := p.baseDelay << uint(attempt)
+	if d <= 1 {
+		return d
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// sleep blocks for the provided duration or until the provided context is
+// done. If the context is done first, its error is returned.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rewind returns a copy of the provided request with a fresh body so that it
+// can be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody == nil {
+		return r, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot rewind request body")
+	}
+	r.Body = body
+
+	return r, nil
+}
+
+// discard drains and closes the body of the provided response so that its
+// connection can be reused.
+func discard(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
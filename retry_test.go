@@ -0,0 +1,111 @@
+package igdb
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestClient_SetRetry(t *testing.T) {
+	var tests = []struct {
+		name      string
+		attempts  int
+		failures  int
+		status    int
+		hangup    bool
+		wantCalls int32
+		wantErr   error
+	}{
+		{"No retries", 0, 1, http.StatusInternalServerError, false, 1, ErrInternalError},
+		{"Retried service unavailable", 3, 2, http.StatusServiceUnavailable, false, 3, nil},
+		{"Retried bad gateway", 3, 1, http.StatusBadGateway, false, 2, nil},
+		{"Retried too many requests", 3, 1, http.StatusTooManyRequests, false, 2, nil},
+		{"Retried network error", 3, 2, 0, true, 3, nil},
+		{"Attempts exhausted", 2, 5, http.StatusInternalServerError, false, 3, ErrInternalError},
+		{"Bad request not retried", 3, 1, http.StatusBadRequest, false, 1, ErrBadRequest},
+		{"Unauthorized not retried", 3, 1, http.StatusUnauthorized, false, 1, ErrUnauthorized},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&calls, 1)
+
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil || string(b) != "limit 1; " {
+					t.Errorf("got: <%s>, want replayed body <%s>", b, "limit 1; ")
+				}
+
+				if int(n) <= test.failures {
+					if test.hangup {
+						conn, _, _ := w.(http.Hijacker).Hijack()
+						conn.Close()
+						return
+					}
+					w.WriteHeader(test.status)
+					return
+				}
+				w.Write([]byte(`[{"id": 1}]`))
+			})
+			defer ts.Close()
+
+			c.SetRetry(test.attempts, time.Millisecond)
+
+			var g []*Game
+			err := c.post(EndpointGame, &g, SetLimit(1))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if calls != test.wantCalls {
+				t.Errorf("got: <%v> calls, want: <%v>", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_RetryCanceled(t *testing.T) {
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer ts.Close()
+
+	c.SetRetry(5, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var g []*Game
+	err := c.postWithContext(ctx, EndpointGame, &g)
+	if errors.Cause(err) != context.DeadlineExceeded {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), context.DeadlineExceeded)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := retryPolicy{attempts: 5, baseDelay: 100 * time.Millisecond}
+
+	for attempt := 0; attempt < 5; attempt++ {
+		max := p.baseDelay << uint(attempt)
+		for i := 0; i < 20; i++ {
+			d := p.backoff(attempt)
+			if d < max/2 || d >= max {
+				t.Errorf("attempt %d got: <%v>, want between <%v> and <%v>", attempt, d, max/2, max)
+			}
+		}
+	}
+
+	if d := (retryPolicy{}).backoff(3); d != 0 {
+		t.Errorf("got: <%v>, want: <%v>", d, 0)
+	}
+
+	if d := p.backoff(1000); d <= 0 {
+		t.Errorf("got: <%v>, want positive capped delay", d)
+	}
+}
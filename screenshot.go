@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/Henry-Sarabia/sliceconv"
@@ -22,14 +23,23 @@ type ScreenshotService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Screenshots, an error is returned.
 func (ss *ScreenshotService) Get(id int, opts ...Option) (*Screenshot, error) {
+	return ss.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Screenshot identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any Screenshots,
+// an error is returned.
+func (ss *ScreenshotService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Screenshot, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var shot []*Screenshot
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ss.client.post(ss.end, &shot, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ss.client.postWithContext(ctx, ss.end, &shot, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Screenshot with ID %v", id)
 	}
@@ -42,6 +52,15 @@ func (ss *ScreenshotService) Get(id int, opts ...Option) (*Screenshot, error) {
 // Any ID that does not match a Screenshot is ignored. If none of the IDs
 // match a Screenshot, an error is returned.
 func (ss *ScreenshotService) List(ids []int, opts ...Option) ([]*Screenshot, error) {
+	return ss.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Screenshots identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Screenshot is ignored. If
+// none of the IDs match a Screenshot, an error is returned.
+func (ss *ScreenshotService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Screenshot, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -55,7 +74,7 @@ func (ss *ScreenshotService) List(ids []int, opts ...Option) ([]*Screenshot, err
 	var shot []*Screenshot
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ss.client.post(ss.end, &shot, opts...)
+	err := ss.client.postWithContext(ctx, ss.end, &shot, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Screenshots with IDs %v", ids)
 	}
@@ -63,13 +82,43 @@ func (ss *ScreenshotService) List(ids []int, opts ...Option) ([]*Screenshot, err
 	return shot, nil
 }
 
+// ListByGame returns the list of Screenshots belonging to the Game identified by
+// the provided IGDB ID. Up to 500 Screenshots are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no Screenshots, an error is returned.
+func (ss *ScreenshotService) ListByGame(gameID int, opts ...Option) ([]*Screenshot, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var shot []*Screenshot
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := ss.client.post(ss.end, &shot, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Screenshots with Game ID %v", gameID)
+	}
+
+	return shot, nil
+}
+
 // Index returns an index of Screenshots based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no Screenshots can
 // be found using the provided options, an error is returned.
 func (ss *ScreenshotService) Index(opts ...Option) ([]*Screenshot, error) {
+	return ss.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Screenshots based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Screenshots can be found using the provided options, an error
+// is returned.
+func (ss *ScreenshotService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Screenshot, error) {
 	var shot []*Screenshot
 
-	err := ss.client.post(ss.end, &shot, opts...)
+	err := ss.client.postWithContext(ctx, ss.end, &shot, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Screenshots")
 	}
@@ -81,7 +130,15 @@ func (ss *ScreenshotService) Index(opts ...Option) ([]*Screenshot, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Screenshots to count.
 func (ss *ScreenshotService) Count(opts ...Option) (int, error) {
-	ct, err := ss.client.getCount(ss.end, opts...)
+	return ss.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Screenshots available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Screenshots to count.
+func (ss *ScreenshotService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ss.client.getCountWithContext(ctx, ss.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Screenshots")
 	}
@@ -92,7 +149,14 @@ func (ss *ScreenshotService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Screenshot object.
 func (ss *ScreenshotService) Fields() ([]string, error) {
-	f, err := ss.client.getFields(ss.end)
+	return ss.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Screenshot
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ss *ScreenshotService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ss.client.getFieldsWithContext(ctx, ss.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Screenshot fields")
 	}
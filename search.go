@@ -1,24 +1,79 @@
 package igdb
 
-import "github.com/pkg/errors"
+import (
+	"context"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// Errors returned when performing a search.
+var (
+	// ErrEmptySearchTypes occurs when a multi type search is performed without any SearchTypes.
+	ErrEmptySearchTypes = errors.New("search types argument empty")
+	// ErrInvalidSearchType occurs when an unsupported SearchType is used as an argument.
+	ErrInvalidSearchType = errors.New("invalid search type")
+)
 
 //go:generate gomodifytags -file $GOFILE -struct SearchResult -add-tags json -w
 
 // SearchResult represents a result from searching the IGDB.
 // It can contain: Characters, Collections Games, People, Platforms, and Themes.
 type SearchResult struct {
-	AlternativeName string `json:"alternative_name"`
-	Character       int    `json:"character"`
-	Collection      int    `json:"collection"`
-	Company         int    `json:"company"`
-	Description     string `json:"description"`
-	Game            int    `json:"game"`
-	Name            string `json:"name"`
-	Person          int    `json:"person"`
-	Platform        int    `json:"platform"`
-	PublishedAt     int    `json:"published_at"`
-	TestDummy       int    `json:"test_dummy"`
-	Theme           int    `json:"theme"`
+	AlternativeName string    `json:"alternative_name"`
+	Character       int       `json:"character"`
+	Collection      int       `json:"collection"`
+	Company         int       `json:"company"`
+	Description     string    `json:"description"`
+	Game            int       `json:"game"`
+	Name            string    `json:"name"`
+	Person          int       `json:"person"`
+	Platform        int       `json:"platform"`
+	PublishedAt     Timestamp `json:"published_at"`
+	TestDummy       int       `json:"test_dummy"`
+	Theme           int       `json:"theme"`
+}
+
+//go:generate stringer -type=SearchResultType
+
+// SearchResultType specifies the type of IGDB object a SearchResult refers to.
+type SearchResultType int
+
+// Expected SearchResultType enums.
+const (
+	SearchResultUnknown SearchResultType = iota
+	SearchResultCharacter
+	SearchResultCollection
+	SearchResultCompany
+	SearchResultGame
+	SearchResultPerson
+	SearchResultPlatform
+	SearchResultTheme
+)
+
+// Type returns the type of IGDB object the SearchResult refers to based on
+// which of its reference fields is set. If none of the reference fields are
+// set, SearchResultUnknown is returned.
+func (sr *SearchResult) Type() SearchResultType {
+	switch {
+	case sr.Character != 0:
+		return SearchResultCharacter
+	case sr.Collection != 0:
+		return SearchResultCollection
+	case sr.Company != 0:
+		return SearchResultCompany
+	case sr.Game != 0:
+		return SearchResultGame
+	case sr.Person != 0:
+		return SearchResultPerson
+	case sr.Platform != 0:
+		return SearchResultPlatform
+	case sr.Theme != 0:
+		return SearchResultTheme
+	default:
+		return SearchResultUnknown
+	}
 }
 
 // Search returns a list of SearchResults using the provided query. Provide functional
@@ -37,3 +92,131 @@ func (c *Client) Search(qry string, opts ...Option) ([]*SearchResult, error) {
 
 	return res, nil
 }
+
+// SearchType specifies a type of IGDB object to search through.
+type SearchType int
+
+// Available SearchTypes for the MultiTypeSearch service function.
+const (
+	SearchGames SearchType = iota + 1
+	SearchCompanies
+	SearchPlatforms
+)
+
+// MultiTypeSearchResult contains the results of searching through
+// several types of IGDB objects at once. The results for any type
+// that was not searched or had no matches are left empty.
+type MultiTypeSearchResult struct {
+	Games     []*Game
+	Companies []*Company
+	Platforms []*Platform
+}
+
+// SearchService handles the API calls that search
+// through one or more IGDB endpoints.
+type SearchService service
+
+// Index returns a list of SearchResults ranked by relevance to the provided
+// query. Unlike the Search functions of the other services, the results may
+// refer to Characters, Collections, Games, People, Platforms, or Themes; use
+// the Type method of each result to tell them apart. Provide functional
+// options to sort, filter, and paginate the results. If the query is empty,
+// an error is returned without sending a request. If no results are found,
+// an error is returned.
+func (ss *SearchService) Index(qry string, opts ...Option) ([]*SearchResult, error) {
+	return ss.IndexWithContext(context.Background(), qry, opts...)
+}
+
+// IndexWithContext returns a list of SearchResults ranked by relevance to the
+// provided query. The request is canceled if the provided context is canceled
+// or its deadline is exceeded. Unlike the Search functions of the other
+// services, the results may refer to Characters, Collections, Games, People,
+// Platforms, or Themes; use the Type method of each result to tell them apart.
+// Provide functional options to sort, filter, and paginate the results. If the
+// query is empty, an error is returned without sending a request. If no results
+// are found, an error is returned.
+func (ss *SearchService) IndexWithContext(ctx context.Context, qry string, opts ...Option) ([]*SearchResult, error) {
+	if blank.Is(qry) {
+		return nil, ErrEmptyQry
+	}
+
+	var res []*SearchResult
+
+	opts = append(opts, setSearch(qry))
+	err := ss.client.postWithContext(ctx, ss.end, &res, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot perform search with query %s", qry)
+	}
+
+	return res, nil
+}
+
+// MultiTypeSearch searches through each of the provided types of IGDB objects
+// using the provided query and returns up to limit results of each type. A
+// separate API call is made for each type and every call is made concurrently.
+// A type without any matches is left empty instead of returning an error. If
+// any of the API calls fail, the remaining calls are canceled and the first
+// error is returned.
+func (ss *SearchService) MultiTypeSearch(qry string, types []SearchType, limit int) (*MultiTypeSearchResult, error) {
+	if blank.Is(qry) {
+		return nil, ErrEmptyQry
+	}
+
+	if len(types) < 1 {
+		return nil, ErrEmptySearchTypes
+	}
+
+	lim := SetLimit(limit)
+	if _, err := lim(); err != nil {
+		return nil, err
+	}
+
+	uniq := make(map[SearchType]bool, len(types))
+	for _, t := range types {
+		if t < SearchGames || t > SearchPlatforms {
+			return nil, errors.Wrapf(ErrInvalidSearchType, "search type %d", t)
+		}
+		uniq[t] = true
+	}
+
+	g, ctx := errgroup.WithContext(context.Background())
+
+	var res MultiTypeSearchResult
+	for t := range uniq {
+		t := t
+		g.Go(func() error {
+			err := ss.searchType(ctx, &res, t, qry, lim)
+			if errors.Cause(err) == ErrNoResults {
+				return nil
+			}
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// searchType searches through the provided type of IGDB object and stores
+// the results in the matching field of res. Each type only writes to its
+// own field so searchType may be called concurrently for different types.
+// The request is canceled if the provided context is canceled.
+func (ss *SearchService) searchType(ctx context.Context, res *MultiTypeSearchResult, t SearchType, qry string, opts ...Option) error {
+	var err error
+
+	switch t {
+	case SearchGames:
+		res.Games, err = ss.client.Games.SearchWithContext(ctx, qry, opts...)
+	case SearchCompanies:
+		res.Companies, err = ss.client.Companies.SearchWithContext(ctx, qry, opts...)
+	case SearchPlatforms:
+		res.Platforms, err = ss.client.Platforms.SearchWithContext(ctx, qry, opts...)
+	default:
+		err = ErrInvalidSearchType
+	}
+
+	return err
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 const testSearch string = "test_data/search.json"
@@ -46,7 +47,7 @@ func TestClient_Search(t *testing.T) {
 			defer ts.Close()
 
 			z, err := c.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -56,3 +57,190 @@ func TestClient_Search(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*SearchResult, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		file     string
+		qry      string
+		opts     []Option
+		wantReqs int
+		wantRes  []*SearchResult
+		wantErr  error
+	}{
+		{"Valid response", testSearch, "sonic", []Option{SetFields("*")}, 1, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, 0, nil, ErrEmptyQry},
+		{"Blank query", testFileEmpty, "   ", nil, 0, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "sonic", nil, 1, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "sonic", []Option{SetOffset(-99999)}, 0, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, 1, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reqs++
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			res, err := c.Searches.Index(test.qry, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.wantRes) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.wantRes)
+			}
+
+			if reqs != test.wantReqs {
+				t.Errorf("got: <%v>, want: <%v>", reqs, test.wantReqs)
+			}
+		})
+	}
+}
+
+func TestSearchResult_Type(t *testing.T) {
+	var tests = []struct {
+		name     string
+		res      SearchResult
+		wantType SearchResultType
+	}{
+		{"Character", SearchResult{Character: 1}, SearchResultCharacter},
+		{"Collection", SearchResult{Collection: 1}, SearchResultCollection},
+		{"Company", SearchResult{Company: 1}, SearchResultCompany},
+		{"Game", SearchResult{Name: "Sonic", Game: 1}, SearchResultGame},
+		{"Person", SearchResult{Person: 1}, SearchResultPerson},
+		{"Platform", SearchResult{Platform: 1}, SearchResultPlatform},
+		{"Theme", SearchResult{Theme: 1}, SearchResultTheme},
+		{"Unknown", SearchResult{Name: "Sonic"}, SearchResultUnknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.res.Type() != test.wantType {
+				t.Errorf("got: <%v>, want: <%v>", test.res.Type(), test.wantType)
+			}
+		})
+	}
+}
+
+func TestSearchService_MultiTypeSearch(t *testing.T) {
+	files := map[string]string{
+		"/" + string(EndpointGame):     testGameSearch,
+		"/" + string(EndpointCompany):  testCompanyList,
+		"/" + string(EndpointPlatform): testPlatformSearch,
+	}
+
+	var games []*Game
+	var comps []*Company
+	var plats []*Platform
+	for file, dst := range map[string]interface{}{testGameSearch: &games, testCompanyList: &comps, testPlatformSearch: &plats} {
+		f, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = json.Unmarshal(f, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tests = []struct {
+		name    string
+		qry     string
+		types   []SearchType
+		limit   int
+		empty   string
+		status  int
+		wantRes *MultiTypeSearchResult
+		wantErr error
+	}{
+		{"All types", "mario", []SearchType{SearchGames, SearchCompanies, SearchPlatforms}, 5, "", http.StatusOK, &MultiTypeSearchResult{Games: games, Companies: comps, Platforms: plats}, nil},
+		{"Single type", "mario", []SearchType{SearchPlatforms}, 5, "", http.StatusOK, &MultiTypeSearchResult{Platforms: plats}, nil},
+		{"Duplicate types", "mario", []SearchType{SearchGames, SearchGames}, 5, "", http.StatusOK, &MultiTypeSearchResult{Games: games}, nil},
+		{"Type without results", "mario", []SearchType{SearchGames, SearchCompanies}, 5, "/" + string(EndpointCompany), http.StatusOK, &MultiTypeSearchResult{Games: games}, nil},
+		{"Empty query", "", []SearchType{SearchGames}, 5, "", http.StatusOK, nil, ErrEmptyQry},
+		{"Empty types", "mario", nil, 5, "", http.StatusOK, nil, ErrEmptySearchTypes},
+		{"Invalid type", "mario", []SearchType{SearchGames, SearchType(99)}, 5, "", http.StatusOK, nil, ErrInvalidSearchType},
+		{"Invalid limit", "mario", []SearchType{SearchGames}, -1, "", http.StatusOK, nil, ErrOutOfRange},
+		{"Failed search", "mario", []SearchType{SearchGames, SearchPlatforms}, 5, "", http.StatusInternalServerError, nil, ErrInternalError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == test.empty {
+					w.Write([]byte("[]"))
+					return
+				}
+
+				if test.status != http.StatusOK {
+					w.WriteHeader(test.status)
+					return
+				}
+
+				f, err := ioutil.ReadFile(files[r.URL.Path])
+				if err != nil {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write(f)
+			})
+			defer ts.Close()
+
+			res, err := c.Searches.MultiTypeSearch(test.qry, test.types, test.limit)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(res, test.wantRes) {
+				t.Errorf("got: <%v>, \nwant: <%v>", res, test.wantRes)
+			}
+		})
+	}
+}
+
+func TestSearchService_MultiTypeSearch_Cancel(t *testing.T) {
+	done := make(chan struct{})
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+string(EndpointGame) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			w.Write([]byte("[]"))
+		}
+	})
+	defer ts.Close()
+	defer close(done)
+
+	start := time.Now()
+	_, err := c.Searches.MultiTypeSearch("mario", []SearchType{SearchGames, SearchPlatforms}, 5)
+	if !errors.Is(err, ErrInternalError) {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrInternalError)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("got: <%v>, want sibling search canceled without waiting for its response", elapsed)
+	}
+}
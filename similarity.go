@@ -0,0 +1,113 @@
+package igdb
+
+// GameSimilarityWeights specifies how much each category of shared
+// attributes contributes to the similarity score of two Games. The weights
+// are relative to one another and do not need to add up to 1.
+type GameSimilarityWeights struct {
+	Genres             float64
+	Themes             float64
+	Keywords           float64
+	PlayerPerspectives float64
+	GameModes          float64
+	Platforms          float64
+	Franchises         float64
+}
+
+// DefaultGameSimilarityWeights are the weights used by GameSimilarity.
+var DefaultGameSimilarityWeights = GameSimilarityWeights{
+	Genres:             0.30,
+	Themes:             0.20,
+	Keywords:           0.15,
+	PlayerPerspectives: 0.10,
+	GameModes:          0.10,
+	Platforms:          0.10,
+	Franchises:         0.05,
+}
+
+// GameSimilarity returns a score between 0 and 1 describing how similar the
+// two provided Games are using the DefaultGameSimilarityWeights. For more
+// information, see GameSimilarityWeights.Similarity.
+func GameSimilarity(a, b *Game) float64 {
+	return DefaultGameSimilarityWeights.Similarity(a, b)
+}
+
+// Similarity returns a score between 0 and 1 describing how similar the two
+// provided Games are. Each category (e.g. genres or themes) is scored by the
+// overlap of its IDs between the two Games and weighted accordingly. Categories
+// that neither Game has any data for are ignored so that missing fields do not
+// lower the score. No API calls are made; only the fields already present in
+// the Games are compared.
+func (w GameSimilarityWeights) Similarity(a, b *Game) float64 {
+	if a == nil || b == nil {
+		return 0
+	}
+
+	cats := []struct {
+		weight float64
+		x, y   []int
+	}{
+		{w.Genres, a.Genres, b.Genres},
+		{w.Themes, a.Themes, b.Themes},
+		{w.Keywords, a.Keywords, b.Keywords},
+		{w.PlayerPerspectives, a.PlayerPerspectives, b.PlayerPerspectives},
+		{w.GameModes, a.GameModes, b.GameModes},
+		{w.Platforms, a.Platforms, b.Platforms},
+		{w.Franchises, franchiseIDs(a), franchiseIDs(b)},
+	}
+
+	var score, total float64
+	for _, c := range cats {
+		if c.weight <= 0 || (len(c.x) == 0 && len(c.y) == 0) {
+			continue
+		}
+
+		score += c.weight * overlap(c.x, c.y)
+		total += c.weight
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return score / total
+}
+
+// franchiseIDs returns the IDs of every franchise the provided Game belongs to.
+func franchiseIDs(g *Game) []int {
+	if g.Franchise == 0 {
+		return g.Franchises
+	}
+
+	return append([]int{g.Franchise}, g.Franchises...)
+}
+
+// overlap returns the Jaccard index of the two provided sets of IDs; the
+// number of shared IDs divided by the number of distinct IDs.
+func overlap(x, y []int) float64 {
+	set := make(map[int]bool, len(x))
+	for _, id := range x {
+		set[id] = true
+	}
+
+	union := len(set)
+	var shared int
+	seen := make(map[int]bool, len(y))
+	for _, id := range y {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if set[id] {
+			shared++
+			continue
+		}
+		union++
+	}
+
+	if union == 0 {
+		return 0
+	}
+
+	return float64(shared) / float64(union)
+}
@@ -0,0 +1,92 @@
+package igdb
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGameSimilarity(t *testing.T) {
+	zelda := &Game{
+		Genres:             []int{12, 31},
+		Themes:             []int{1, 17},
+		Keywords:           []int{100, 200, 300},
+		PlayerPerspectives: []int{2},
+		GameModes:          []int{1},
+		Platforms:          []int{130},
+		Franchise:          596,
+	}
+
+	var tests = []struct {
+		name      string
+		a         *Game
+		b         *Game
+		wantScore float64
+	}{
+		{"Identical games", zelda, zelda, 1},
+		{"Nil game", zelda, nil, 0},
+		{"Empty games", &Game{}, &Game{}, 0},
+		{"Nothing shared", zelda, &Game{Genres: []int{5}, Platforms: []int{6}}, 0},
+		{
+			"Only genres populated",
+			&Game{Genres: []int{12, 31}},
+			&Game{Genres: []int{12}},
+			0.5,
+		},
+		{
+			"Genres and themes populated",
+			&Game{Genres: []int{12}, Themes: []int{1}},
+			&Game{Genres: []int{12}, Themes: []int{17}},
+			0.30 / 0.50,
+		},
+		{
+			"Franchise in either field",
+			&Game{Franchise: 596},
+			&Game{Franchises: []int{596}},
+			1,
+		},
+		{
+			"Duplicate IDs",
+			&Game{Genres: []int{12, 12}},
+			&Game{Genres: []int{12, 12, 31}},
+			0.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := GameSimilarity(test.a, test.b)
+			if math.Abs(got-test.wantScore) > 1e-9 {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantScore)
+			}
+
+			if got < 0 || got > 1 {
+				t.Errorf("score <%v> out of range", got)
+			}
+		})
+	}
+}
+
+func TestGameSimilarityWeights_Similarity(t *testing.T) {
+	a := &Game{Genres: []int{12}, Platforms: []int{6}}
+	b := &Game{Genres: []int{12}, Platforms: []int{48}}
+
+	var tests = []struct {
+		name      string
+		weights   GameSimilarityWeights
+		wantScore float64
+	}{
+		{"Genres only", GameSimilarityWeights{Genres: 1}, 1},
+		{"Platforms only", GameSimilarityWeights{Platforms: 1}, 0},
+		{"Even split", GameSimilarityWeights{Genres: 1, Platforms: 1}, 0.5},
+		{"Zero weights", GameSimilarityWeights{}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.weights.Similarity(a, b)
+			if math.Abs(got-test.wantScore) > 1e-9 {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantScore)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// statusTimeLayouts are the layouts used by the IGDB for the start and end
+// of a usage report period.
+var statusTimeLayouts = []string{
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+}
+
+//go:generate gomodifytags -file $GOFILE -struct Status -add-tags json -w
+
+// Status contains the usage reports for the user's API key along with other
+// metadata, such as whether the key is authorized and the plan it uses.
+// For more information visit: https://api-docs.igdb.com/#api-status
+type Status struct {
+	Authorized   bool          `json:"authorized"`
+	Plan         string        `json:"plan"`
+	UsageReports []UsageReport `json:"usage_reports"`
+}
+
+// UnmarshalJSON decodes a Status whose usage reports are either an array or
+// an object of named reports. Named reports are sorted by name.
+func (s *Status) UnmarshalJSON(b []byte) error {
+	type alias Status
+	aux := struct {
+		*alias
+		UsageReports json.RawMessage `json:"usage_reports"`
+	}{alias: (*alias)(s)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	s.UsageReports = nil
+	if len(aux.UsageReports) == 0 || string(aux.UsageReports) == "null" {
+		return nil
+	}
+
+	if aux.UsageReports[0] == '[' {
+		return json.Unmarshal(aux.UsageReports, &s.UsageReports)
+	}
+
+	var named map[string]UsageReport
+	if err := json.Unmarshal(aux.UsageReports, &named); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(named))
+	for n := range named {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		s.UsageReports = append(s.UsageReports, named[n])
+	}
+
+	return nil
+}
+
+//go:generate gomodifytags -file $GOFILE -struct UsageReport -add-tags json -w
+
+// UsageReport contains information and statistics for the user's API usage
+// in the current period.
+type UsageReport struct {
+	Metric       string    `json:"metric"`
+	Period       string    `json:"period"`
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
+	MaxValue     int       `json:"max_value"`
+	CurrentValue int       `json:"current_value"`
+}
+
+// UnmarshalJSON decodes a UsageReport whose period start and end are
+// reported as formatted date strings.
+func (ur *UsageReport) UnmarshalJSON(b []byte) error {
+	type alias UsageReport
+	aux := struct {
+		*alias
+		PeriodStart string `json:"period_start"`
+		PeriodEnd   string `json:"period_end"`
+	}{alias: (*alias)(ur)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if ur.PeriodStart, err = parseStatusTime(aux.PeriodStart); err != nil {
+		return err
+	}
+	if ur.PeriodEnd, err = parseStatusTime(aux.PeriodEnd); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseStatusTime parses the provided usage report time using each of the
+// known layouts and returns it in UTC. An empty string results in the zero
+// time.
+func parseStatusTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range statusTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.Errorf("cannot parse usage report time %q", s)
+}
+
+// Status returns the status of the user's API key, including its usage in the
+// current period and when that period ends. This is useful as a health check
+// before making other API calls. If the API key is not authorized, an error
+// matching ErrUnauthorized using errors.Is is returned, which can also be
+// detected using IsUnauthorized.
+// For more information visit: https://api-docs.igdb.com/#api-status
+func (c *Client) Status() (*Status, error) {
+	return c.StatusWithContext(context.Background())
+}
+
+// StatusWithContext returns the status of the user's API key. The request is
+// canceled if the provided context is canceled or its deadline is exceeded.
+// See Status for details.
+func (c *Client) StatusWithContext(ctx context.Context) (*Status, error) {
+	var stat []*Status
+
+	err := c.postWithContext(ctx, EndpointStatus, &stat)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get API status")
+	}
+
+	if len(stat) == 0 || stat[0] == nil {
+		return nil, errors.Wrap(ErrNoResults, "cannot get API status")
+	}
+
+	if !stat[0].Authorized {
+		return nil, errors.Wrap(ErrUnauthorized, "API key is not authorized")
+	}
+
+	return stat[0], nil
+}
@@ -0,0 +1,158 @@
+package igdb
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const testStatus string = "test_data/status.json"
+
+func TestClient_Status(t *testing.T) {
+	want := &Status{
+		Authorized: true,
+		Plan:       "Free",
+		UsageReports: []UsageReport{
+			{
+				Metric:       "hits",
+				Period:       "month",
+				PeriodStart:  time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				MaxValue:     10000,
+				CurrentValue: 1,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		file     string
+		status   int
+		wantStat *Status
+		wantErr  error
+	}{
+		{"Valid response", testStatus, http.StatusOK, want, nil},
+		{"Empty response", testFileEmpty, http.StatusOK, nil, errInvalidJSON},
+		{"No results", testFileEmptyArray, http.StatusOK, nil, ErrNoResults},
+		{"Status Unauthorized", testFileEmpty, http.StatusUnauthorized, nil, ErrUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(test.status, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			stat, err := c.Status()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr == ErrUnauthorized && !IsUnauthorized(err) {
+				t.Errorf("got: <%v>, want unauthorized error", err)
+			}
+
+			if !reflect.DeepEqual(stat, test.wantStat) {
+				t.Errorf("got: <%v>, \nwant: <%v>", stat, test.wantStat)
+			}
+		})
+	}
+}
+
+func TestClient_Status_Unauthorized(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, `[{"authorized": false, "plan": "Free"}]`)
+	defer ts.Close()
+
+	stat, err := c.Status()
+	if !errors.Is(err, ErrUnauthorized) || !IsUnauthorized(err) {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrUnauthorized)
+	}
+
+	if stat != nil {
+		t.Errorf("got: <%v>, want: <nil>", stat)
+	}
+}
+
+func TestClient_StatusWithContext(t *testing.T) {
+	ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.StatusWithContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+}
+
+func TestStatus_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantReports []UsageReport
+		wantErr     bool
+	}{
+		{
+			"Named reports",
+			`{"usage_reports": {"b_report": {"metric": "b"}, "a_report": {"metric": "a"}}}`,
+			[]UsageReport{{Metric: "a"}, {Metric: "b"}},
+			false,
+		},
+		{
+			"Array of reports",
+			`{"usage_reports": [{"metric": "hits", "period_start": "2019-05-01T00:00:00Z"}]}`,
+			[]UsageReport{{Metric: "hits", PeriodStart: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)}},
+			false,
+		},
+		{
+			"Missing reports",
+			`{"authorized": true}`,
+			nil,
+			false,
+		},
+		{
+			"Null reports",
+			`{"usage_reports": null}`,
+			nil,
+			false,
+		},
+		{
+			"Invalid time",
+			`{"usage_reports": {"usage_report": {"period_start": "yesterday"}}}`,
+			nil,
+			true,
+		},
+		{
+			"Invalid reports",
+			`{"usage_reports": "none"}`,
+			nil,
+			true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var s Status
+
+			err := s.UnmarshalJSON([]byte(test.body))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if test.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(s.UsageReports, test.wantReports) {
+				t.Errorf("got: <%v>, want: <%v>", s.UsageReports, test.wantReports)
+			}
+		})
+	}
+}
@@ -40,10 +40,10 @@ type testHeader struct {
 // the initialized test server.
 func startTestServer(status int, resp io.Reader, headers ...testHeader) (*httptest.Server, *Client) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(status)
 		for _, h := range headers {
 			w.Header().Add(h.Key, h.Value)
 		}
+		w.WriteHeader(status)
 		io.Copy(w, resp)
 	}))
 
@@ -53,6 +53,18 @@ func startTestServer(status int, resp io.Reader, headers ...testHeader) (*httpte
 	return ts, c
 }
 
+// testServerFunc initializes and returns a test server that will respond to every request using
+// the provided handler. testServerFunc also returns a Client configured specifically for the
+// initialized test server. Use testServerFunc when a test makes more than one request.
+func testServerFunc(handler http.HandlerFunc) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(handler)
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
 // testServerString initializes and returns a test server that will respond with the provided
 // status, response, and optional headers. testServerString also returns a Client configured
 // specifically for the initialized test server.
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -11,12 +12,12 @@ import (
 // Theme represents a particular video game theme.
 // For more information visit: https://api-docs.igdb.com/#theme
 type Theme struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
 }
 
 // ThemeService handles all the API calls for the IGDB Theme endpoint.
@@ -26,14 +27,23 @@ type ThemeService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Themes, an error is returned.
 func (ts *ThemeService) Get(id int, opts ...Option) (*Theme, error) {
+	return ts.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Theme identified by the provided IGDB ID. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Themes, an error is
+// returned.
+func (ts *ThemeService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Theme, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var th []*Theme
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ts.client.post(ts.end, &th, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ts.client.postWithContext(ctx, ts.end, &th, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Theme with ID %v", id)
 	}
@@ -46,6 +56,15 @@ func (ts *ThemeService) Get(id int, opts ...Option) (*Theme, error) {
 // Any ID that does not match a Theme is ignored. If none of the IDs
 // match a Theme, an error is returned.
 func (ts *ThemeService) List(ids []int, opts ...Option) ([]*Theme, error) {
+	return ts.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Themes identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Theme is ignored. If none
+// of the IDs match a Theme, an error is returned.
+func (ts *ThemeService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Theme, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -59,7 +78,7 @@ func (ts *ThemeService) List(ids []int, opts ...Option) ([]*Theme, error) {
 	var th []*Theme
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ts.client.post(ts.end, &th, opts...)
+	err := ts.client.postWithContext(ctx, ts.end, &th, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Themes with IDs %v", ids)
 	}
@@ -68,12 +87,26 @@ func (ts *ThemeService) List(ids []int, opts ...Option) ([]*Theme, error) {
 }
 
 // Index returns an index of Themes based solely on the provided functional
-// options used to sort, filter, and paginate the results. If no Themes can
-// be found using the provided options, an error is returned.
+// options used to sort, filter, and paginate the results. Unless otherwise
+// specified, every field of up to 500 Themes is retrieved so that calling
+// Index without any options returns the full list of Themes. If no Themes
+// can be found using the provided options, an error is returned.
 func (ts *ThemeService) Index(opts ...Option) ([]*Theme, error) {
+	return ts.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Themes based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Unless otherwise specified, every field of up to 500 Themes is
+// retrieved so that calling Index without any options returns the full list of
+// Themes. If no Themes can be found using the provided options, an error is
+// returned.
+func (ts *ThemeService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Theme, error) {
 	var th []*Theme
 
-	err := ts.client.post(ts.end, &th, opts...)
+	opts = withIndexDefaults(opts)
+	err := ts.client.postWithContext(ctx, ts.end, &th, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Themes")
 	}
@@ -85,10 +118,19 @@ func (ts *ThemeService) Index(opts ...Option) ([]*Theme, error) {
 // query. Provide functional options to sort, filter, and paginate the results. If
 // no Themes are found using the provided query, an error is returned.
 func (ts *ThemeService) Search(qry string, opts ...Option) ([]*Theme, error) {
+	return ts.SearchWithContext(context.Background(), qry, opts...)
+}
+
+// SearchWithContext returns a list of Themes found by searching the IGDB using
+// the provided query. The request is canceled if the provided context is
+// canceled or its deadline is exceeded. Provide functional options to sort,
+// filter, and paginate the results. If no Themes are found using the provided
+// query, an error is returned.
+func (ts *ThemeService) SearchWithContext(ctx context.Context, qry string, opts ...Option) ([]*Theme, error) {
 	var th []*Theme
 
 	opts = append(opts, setSearch(qry))
-	err := ts.client.post(ts.end, &th, opts...)
+	err := ts.client.postWithContext(ctx, ts.end, &th, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Theme with query %s", qry)
 	}
@@ -100,7 +142,15 @@ func (ts *ThemeService) Search(qry string, opts ...Option) ([]*Theme, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Themes to count.
 func (ts *ThemeService) Count(opts ...Option) (int, error) {
-	ct, err := ts.client.getCount(ts.end, opts...)
+	return ts.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Themes available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Themes to count.
+func (ts *ThemeService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ts.client.getCountWithContext(ctx, ts.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Themes")
 	}
@@ -111,7 +161,14 @@ func (ts *ThemeService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Theme object.
 func (ts *ThemeService) Fields() ([]string, error) {
-	f, err := ts.client.getFields(ts.end)
+	return ts.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Theme
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ts *ThemeService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ts.client.getFieldsWithContext(ctx, ts.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Theme fields")
 	}
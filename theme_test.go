@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -50,7 +51,7 @@ func TestThemeService_Get(t *testing.T) {
 			defer ts.Close()
 
 			th, err := c.Themes.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -97,7 +98,7 @@ func TestThemeService_List(t *testing.T) {
 			defer ts.Close()
 
 			th, err := c.Themes.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -141,7 +142,7 @@ func TestThemeService_Index(t *testing.T) {
 			defer ts.Close()
 
 			th, err := c.Themes.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -187,7 +188,7 @@ func TestThemeService_Search(t *testing.T) {
 			defer ts.Close()
 
 			th, err := c.Themes.Search(test.qry, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -198,6 +199,44 @@ func TestThemeService_Search(t *testing.T) {
 	}
 }
 
+func TestThemeService_IndexDefaults(t *testing.T) {
+	var tests = []struct {
+		name    string
+		opts    []Option
+		wantQry []string
+	}{
+		{"No options", nil, []string{"fields *;", "limit 500;"}},
+		{"Custom fields", []Option{SetFields("name")}, []string{"fields name;", "limit 500;"}},
+		{"Custom limit", []Option{SetLimit(5)}, []string{"fields *;", "limit 5;"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				w.Write([]byte(`[{"id": 1, "name": "Fantasy"}]`))
+			})
+			defer ts.Close()
+
+			_, err := c.Themes.Index(test.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.wantQry {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
 func TestThemeService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -218,7 +257,7 @@ func TestThemeService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Themes.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -249,7 +288,7 @@ func TestThemeService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Themes.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
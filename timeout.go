@@ -0,0 +1,50 @@
+package igdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// clauseTimeout is the query clause set by SetRequestTimeout. It is
+// never written to the body of a request.
+const clauseTimeout = "timeout"
+
+// SetRequestTimeout is a functional option used to limit the time taken by a
+// single API call, including any retries. If the call is also given a context
+// with a deadline, the earlier of the two deadlines applies. When the timeout
+// expires, the returned error matches context.DeadlineExceeded using
+// errors.Is. A zero or negative duration returns an error.
+func SetRequestTimeout(d time.Duration) Option {
+	return func() (apicalypse.Option, error) {
+		if d <= 0 {
+			return nil, errors.Wrapf(ErrOutOfRange, "SetRequestTimeout value %v is not positive", d)
+		}
+
+		return func(filters map[string]string) error {
+			filters[clauseTimeout] = d.String()
+			return nil
+		}, nil
+	}
+}
+
+// withTimeout returns a copy of the provided context that is canceled once
+// the timeout set by any of the provided options expires, along with a
+// function that releases its resources. If no timeout is set, the provided
+// context is returned unchanged.
+func withTimeout(ctx context.Context, opts []Option) (context.Context, context.CancelFunc) {
+	for _, opt := range opts {
+		v, ok := optionClauses(opt)[clauseTimeout]
+		if !ok {
+			continue
+		}
+
+		if d, err := time.ParseDuration(v); err == nil {
+			return context.WithTimeout(ctx, d)
+		}
+	}
+
+	return ctx, func() {}
+}
@@ -0,0 +1,133 @@
+package igdb
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestSetRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantQry string
+		wantErr error
+	}{
+		{"Positive timeout", []Option{SetRequestTimeout(time.Second)}, "", nil},
+		{"Positive timeout with other options", []Option{SetLimit(5), SetRequestTimeout(time.Second)}, "limit 5; ", nil},
+		{"Zero timeout", []Option{SetRequestTimeout(0)}, "", ErrOutOfRange},
+		{"Negative timeout", []Option{SetRequestTimeout(-time.Second)}, "", ErrOutOfRange},
+		{"Duplicate timeout", []Option{SetRequestTimeout(time.Second), SetRequestTimeout(time.Minute)}, "", ErrDuplicateOption},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewClient(testClientID, testToken, nil)
+
+			req, err := c.request(testEndpoint, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.wantQry {
+				t.Errorf("got: <%v>, want: <%v>", string(b), test.wantQry)
+			}
+		})
+	}
+}
+
+// testSlowServer returns a handler that responds with a result after the
+// provided delay, unless the request is canceled first.
+func testSlowServer(delay time.Duration, reqs *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(reqs, 1)
+
+		select {
+		case <-time.After(delay):
+			w.Write([]byte(`[{"id": 1}]`))
+		case <-r.Context().Done():
+		}
+	}
+}
+
+func TestClient_RequestTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		delay      time.Duration
+		ctxTimeout time.Duration
+		timeout    time.Duration
+		retries    int
+		wantErr    error
+	}{
+		{"Timeout not reached", 0, 0, time.Minute, 0, nil},
+		{"Timeout reached", time.Second, 0, 20 * time.Millisecond, 0, context.DeadlineExceeded},
+		{"Shorter context deadline", time.Second, 20 * time.Millisecond, time.Minute, 0, context.DeadlineExceeded},
+		{"Shorter timeout", time.Second, time.Minute, 20 * time.Millisecond, 0, context.DeadlineExceeded},
+		{"Timeout reached with retries", time.Second, 0, 20 * time.Millisecond, 100, context.DeadlineExceeded},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var reqs int32
+			ts, c := testServerFunc(testSlowServer(test.delay, &reqs))
+			defer ts.Close()
+
+			c.SetRetry(test.retries, time.Millisecond)
+
+			ctx := context.Background()
+			if test.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.ctxTimeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			_, err := c.Games.IndexWithContext(ctx, SetRequestTimeout(test.timeout))
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", err, test.wantErr)
+			}
+
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Errorf("got: <%v> elapsed, want the call to end at the deadline", elapsed)
+			}
+
+			if test.wantErr != nil && atomic.LoadInt32(&reqs) > 1 {
+				t.Errorf("got: <%v> requests, want: <%v> request", reqs, 1)
+			}
+		})
+	}
+}
+
+func TestClient_RequestTimeout_Count(t *testing.T) {
+	var reqs int32
+	ts, c := testServerFunc(testSlowServer(time.Second, &reqs))
+	defer ts.Close()
+
+	_, err := c.Games.Count(SetRequestTimeout(20 * time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_RequestTimeout_Metadata(t *testing.T) {
+	var reqs int32
+	ts, c := testServerFunc(testSlowServer(time.Second, &reqs))
+	defer ts.Close()
+
+	_, err := c.Games.GetMetadata(SetRequestTimeout(20 * time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: <%v>, want: <%v>", err, context.DeadlineExceeded)
+	}
+}
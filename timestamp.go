@@ -0,0 +1,66 @@
+package igdb
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxUnixSeconds is the largest magnitude treated as a Unix timestamp in
+// seconds. Larger values are treated as milliseconds, which some older IGDB
+// records use; as seconds they would lie more than 3000 years in the future.
+const maxUnixSeconds = 1e11
+
+// Timestamp represents a point in time reported by the IGDB as a Unix
+// timestamp. Timestamp embeds a time.Time so it can be used anywhere a
+// time.Time is expected. A zero or missing timestamp results in the zero
+// time.Time rather than the Unix epoch.
+type Timestamp struct {
+	time.Time
+}
+
+// TimestampFromTime returns the provided time as a Unix timestamp in seconds
+// formatted for use in a filter, such as the SetFilter functional option or
+// the value of a Condition.
+func TimestampFromTime(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// UnmarshalJSON decodes a Unix timestamp into the Timestamp. Timestamps in
+// milliseconds are detected by their magnitude and converted accordingly.
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "cannot unmarshal %s into Timestamp", s)
+	}
+
+	if n == 0 {
+		*t = Timestamp{}
+		return nil
+	}
+
+	if n > maxUnixSeconds || n < -maxUnixSeconds {
+		t.Time = time.Unix(0, n*int64(time.Millisecond)).UTC()
+		return nil
+	}
+
+	t.Time = time.Unix(n, 0).UTC()
+	return nil
+}
+
+// MarshalJSON encodes the Timestamp as a Unix timestamp in seconds.
+// The zero Timestamp is encoded as 0.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
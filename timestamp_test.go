@@ -0,0 +1,73 @@
+package igdb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	var tests = []struct {
+		name     string
+		data     string
+		wantTime time.Time
+		wantErr  bool
+	}{
+		{"Seconds", "1554076800", time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Milliseconds", "1554076800000", time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Negative seconds", "-315619200", time.Date(1960, time.January, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Zero", "0", time.Time{}, false},
+		{"Null", "null", time.Time{}, false},
+		{"String", `"yesterday"`, time.Time{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var ts Timestamp
+			err := json.Unmarshal([]byte(test.data), &ts)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if !ts.Equal(test.wantTime) {
+				t.Errorf("got: <%v>, want: <%v>", ts.Time, test.wantTime)
+			}
+
+			if ts.IsZero() != test.wantTime.IsZero() {
+				t.Errorf("got: <%v>, want: <%v>", ts.IsZero(), test.wantTime.IsZero())
+			}
+		})
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	var tests = []struct {
+		name     string
+		ts       Timestamp
+		wantData string
+	}{
+		{"Seconds", Timestamp{time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC)}, "1554076800"},
+		{"Zero", Timestamp{}, "0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != test.wantData {
+				t.Errorf("got: <%v>, want: <%v>", string(b), test.wantData)
+			}
+		})
+	}
+}
+
+func TestTimestampFromTime(t *testing.T) {
+	got := TimestampFromTime(time.Date(2019, time.April, 1, 2, 0, 0, 0, time.FixedZone("CEST", 2*60*60)))
+	want := "1554076800"
+	if got != want {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
@@ -0,0 +1,210 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct TimeToBeat -add-tags json -w
+
+// TimeToBeat represents the average time it takes players to complete a
+// particular game. The IGDB reports each duration in seconds.
+// For more information visit: https://api-docs.igdb.com/#game-time-to-beat
+type TimeToBeat struct {
+	ID         int           `json:"id"`
+	Completely time.Duration `json:"completely"`
+	Count      int           `json:"count"`
+	CreatedAt  Timestamp     `json:"created_at"`
+	GameID     int           `json:"game_id"`
+	Hastily    time.Duration `json:"hastily"`
+	Normally   time.Duration `json:"normally"`
+	UpdatedAt  Timestamp     `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes a TimeToBeat whose durations are reported in seconds.
+func (ttb *TimeToBeat) UnmarshalJSON(b []byte) error {
+	type alias TimeToBeat
+	aux := struct {
+		*alias
+		Completely int64 `json:"completely"`
+		Hastily    int64 `json:"hastily"`
+		Normally   int64 `json:"normally"`
+	}{alias: (*alias)(ttb)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	ttb.Completely = time.Duration(aux.Completely) * time.Second
+	ttb.Hastily = time.Duration(aux.Hastily) * time.Second
+	ttb.Normally = time.Duration(aux.Normally) * time.Second
+	return nil
+}
+
+// MarshalJSON encodes a TimeToBeat with its durations in whole seconds.
+func (ttb TimeToBeat) MarshalJSON() ([]byte, error) {
+	type alias TimeToBeat
+	return json.Marshal(struct {
+		alias
+		Completely int64 `json:"completely"`
+		Hastily    int64 `json:"hastily"`
+		Normally   int64 `json:"normally"`
+	}{
+		alias:      alias(ttb),
+		Completely: int64(ttb.Completely / time.Second),
+		Hastily:    int64(ttb.Hastily / time.Second),
+		Normally:   int64(ttb.Normally / time.Second),
+	})
+}
+
+// TimeToBeatService handles all the API calls for the IGDB TimeToBeat endpoint.
+type TimeToBeatService service
+
+// Get returns a single TimeToBeat identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any TimeToBeats, an error is returned.
+func (ts *TimeToBeatService) Get(id int, opts ...Option) (*TimeToBeat, error) {
+	return ts.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single TimeToBeat identified by the provided IGDB
+// ID. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide the SetFields functional option if you need to
+// specify which fields to retrieve. If the ID does not match any TimeToBeats,
+// an error is returned.
+func (ts *TimeToBeatService) GetWithContext(ctx context.Context, id int, opts ...Option) (*TimeToBeat, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var ttb []*TimeToBeat
+
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ts.client.postWithContext(ctx, ts.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get TimeToBeat with ID %v", id)
+	}
+
+	return ttb[0], nil
+}
+
+// ByGame returns the TimeToBeat of the Game identified by the provided
+// IGDB ID. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the Game has no TimeToBeat, an error is
+// returned.
+func (ts *TimeToBeatService) ByGame(gameID int, opts ...Option) (*TimeToBeat, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var ttb []*TimeToBeat
+
+	opts = append(requireResults(opts), SetFilter("game_id", OpEquals, strconv.Itoa(gameID)))
+	err := ts.client.post(ts.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get TimeToBeat with Game ID %v", gameID)
+	}
+
+	return ttb[0], nil
+}
+
+// List returns a list of TimeToBeats identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a TimeToBeat is ignored. If none of the IDs
+// match a TimeToBeat, an error is returned.
+func (ts *TimeToBeatService) List(ids []int, opts ...Option) ([]*TimeToBeat, error) {
+	return ts.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of TimeToBeats identified by the provided list
+// of IGDB IDs. The request is canceled if the provided context is canceled or
+// its deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a TimeToBeat is ignored. If
+// none of the IDs match a TimeToBeat, an error is returned.
+func (ts *TimeToBeatService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*TimeToBeat, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var ttb []*TimeToBeat
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ts.client.postWithContext(ctx, ts.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get TimeToBeats with IDs %v", ids)
+	}
+
+	return ttb, nil
+}
+
+// Index returns an index of TimeToBeats based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no TimeToBeats can
+// be found using the provided options, an error is returned.
+func (ts *TimeToBeatService) Index(opts ...Option) ([]*TimeToBeat, error) {
+	return ts.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of TimeToBeats based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no TimeToBeats can be found using the provided options, an error
+// is returned.
+func (ts *TimeToBeatService) IndexWithContext(ctx context.Context, opts ...Option) ([]*TimeToBeat, error) {
+	var ttb []*TimeToBeat
+
+	err := ts.client.postWithContext(ctx, ts.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of TimeToBeats")
+	}
+
+	return ttb, nil
+}
+
+// Count returns the number of TimeToBeats available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which TimeToBeats to count.
+func (ts *TimeToBeatService) Count(opts ...Option) (int, error) {
+	return ts.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of TimeToBeats available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// TimeToBeats to count.
+func (ts *TimeToBeatService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ts.client.getCountWithContext(ctx, ts.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count TimeToBeats")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB TimeToBeat object.
+func (ts *TimeToBeatService) Fields() ([]string, error) {
+	return ts.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB TimeToBeat
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ts *TimeToBeatService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ts.client.getFieldsWithContext(ctx, ts.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get TimeToBeat fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,299 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const (
+	testTimeToBeatGet  string = "test_data/timetobeat_get.json"
+	testTimeToBeatList string = "test_data/timetobeat_list.json"
+)
+
+func TestTimeToBeatService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testTimeToBeatGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*TimeToBeat, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		id             int
+		opts           []Option
+		wantTimeToBeat *TimeToBeat
+		wantErr        error
+	}{
+		{"Valid response", testTimeToBeatGet, 7, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 7, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 7, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.TimeToBeats.Get(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantTimeToBeat) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantTimeToBeat)
+			}
+		})
+	}
+}
+
+func TestTimeToBeatService_ByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testTimeToBeatGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*TimeToBeat, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		id             int
+		opts           []Option
+		wantTimeToBeat *TimeToBeat
+		wantErr        error
+	}{
+		{"Valid response", testTimeToBeatGet, 1942, nil, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.TimeToBeats.ByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantTimeToBeat) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantTimeToBeat)
+			}
+		})
+	}
+}
+
+func TestTimeToBeatService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testTimeToBeatList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*TimeToBeat, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name            string
+		file            string
+		ids             []int
+		opts            []Option
+		wantTimeToBeats []*TimeToBeat
+		wantErr         error
+	}{
+		{"Valid response", testTimeToBeatList, []int{7, 8}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{7, 8}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{7, 8}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.TimeToBeats.List(test.ids, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantTimeToBeats) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantTimeToBeats)
+			}
+		})
+	}
+}
+
+func TestTimeToBeatService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testTimeToBeatList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*TimeToBeat, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		file            string
+		opts            []Option
+		wantTimeToBeats []*TimeToBeat
+		wantErr         error
+	}{
+		{"Valid response", testTimeToBeatList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.TimeToBeats.Index(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantTimeToBeats) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantTimeToBeats)
+			}
+		})
+	}
+}
+
+func TestTimeToBeatService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-100)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.TimeToBeats.Count(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+			}
+		})
+	}
+}
+
+func TestTimeToBeatService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.TimeToBeats.Fields()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestTimeToBeat_JSON(t *testing.T) {
+	data := `{"id":7,"completely":236400,"count":412,"created_at":0,"game_id":1942,"hastily":93600,"normally":183600,"updated_at":0}`
+	want := TimeToBeat{
+		ID:         7,
+		Completely: 65*time.Hour + 40*time.Minute,
+		Count:      412,
+		GameID:     1942,
+		Hastily:    26 * time.Hour,
+		Normally:   51 * time.Hour,
+	}
+
+	var got TimeToBeat
+	err := json.Unmarshal([]byte(data), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, \nwant: <%v>", got, want)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotMap, wantMap map[string]interface{}
+	if err := json.Unmarshal(b, &gotMap); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(data), &wantMap); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(gotMap, wantMap) {
+		t.Errorf("got: <%v>, \nwant: <%v>", string(b), data)
+	}
+}
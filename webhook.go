@@ -0,0 +1,161 @@
+package igdb
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// ErrEmptyWebhookArg occurs when a webhook is registered without a callback URL or secret.
+var ErrEmptyWebhookArg = errors.New("webhook callback URL and secret cannot be empty")
+
+// webhookPath is the path of the webhooks registered with the IGDB,
+// relative to the root URL or to an endpoint.
+const webhookPath = "webhooks/"
+
+// headerSecret is the request header containing the secret the IGDB sends
+// alongside each webhook payload.
+const headerSecret = "X-Secret"
+
+// WebhookMethod specifies the type of change to an IGDB object that triggers a webhook.
+type WebhookMethod string
+
+// Available webhook methods
+const (
+	// WebhookCreate triggers a webhook when an object is created.
+	WebhookCreate WebhookMethod = "create"
+	// WebhookDelete triggers a webhook when an object is deleted.
+	WebhookDelete WebhookMethod = "delete"
+	// WebhookUpdate triggers a webhook when an object is updated.
+	WebhookUpdate WebhookMethod = "update"
+)
+
+// Webhook represents a webhook registered with the IGDB to notify a callback
+// URL whenever objects of an endpoint are created, deleted, or updated.
+//
+// For more information, visit: https://api-docs.igdb.com/#webhooks
+type Webhook struct {
+	ID              int    `json:"id"`
+	URL             string `json:"url"`
+	Category        int    `json:"category"`
+	SubCategory     int    `json:"sub_category"`
+	Active          bool   `json:"active"`
+	NumberOfRetries int    `json:"number_of_retries"`
+}
+
+// RegisterWebhook registers a webhook that notifies the provided callback URL
+// whenever an object of the provided endpoint changes according to the
+// provided method. The IGDB includes the provided secret in the X-Secret
+// header of each notification so the receiver can verify its origin.
+func (c *Client) RegisterWebhook(end endpoint, method WebhookMethod, callbackURL, secret string) (*Webhook, error) {
+	if blank.Is(callbackURL) || blank.Is(secret) {
+		return nil, ErrEmptyWebhookArg
+	}
+
+	form := url.Values{}
+	form.Set("url", callbackURL)
+	form.Set("method", string(method))
+	form.Set("secret", secret)
+
+	req, err := c.newRequestWithMethod("POST", string(end)+webhookPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot make webhook request for '%s' endpoint", end)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var w Webhook
+
+	if err = c.send(req, &w); err != nil {
+		return nil, errors.Wrapf(err, "cannot register webhook for '%s' endpoint", end)
+	}
+
+	return &w, nil
+}
+
+// Webhooks returns every webhook registered with the IGDB using the Client's
+// credentials. If no webhooks are registered, an error is returned.
+func (c *Client) Webhooks() ([]*Webhook, error) {
+	req, err := c.newRequestWithMethod("GET", webhookPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot make webhook request")
+	}
+
+	var w []*Webhook
+
+	if err = c.send(req, &w); err != nil {
+		return nil, errors.Wrap(err, "cannot get webhooks")
+	}
+
+	return w, nil
+}
+
+// UnregisterWebhook removes the webhook with the provided ID from the IGDB.
+func (c *Client) UnregisterWebhook(id int) error {
+	if id < 0 {
+		return ErrNegativeID
+	}
+
+	req, err := c.newRequestWithMethod("DELETE", webhookPath+strconv.Itoa(id), nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot make webhook request")
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return errors.Wrap(err, "http client cannot send request")
+	}
+	defer discard(resp)
+
+	if err = checkResponse(resp); err != nil {
+		return errors.Wrapf(err, "cannot unregister webhook with ID %v", id)
+	}
+
+	return nil
+}
+
+// WebhookHandler returns an http.Handler that receives the notifications sent
+// by the IGDB for registered webhooks. Requests without the provided secret
+// in their X-Secret header are rejected, as are all requests if the provided
+// secret is empty. The payload of each notification is
+// passed to the provided function along with the endpoint it concerns, which
+// is taken from the final element of the callback URL path. To receive
+// notifications for several endpoints, register each webhook with a callback
+// URL ending in its endpoint (e.g. https://example.com/igdb/games).
+//
+// If the provided function returns an error, the handler responds with an
+// Internal Server Error status so the IGDB retries the notification.
+func WebhookHandler(secret string, fn func(end endpoint, body []byte) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if secret == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get(headerSecret)), []byte(secret)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil || !json.Valid(b) {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		end := endpoint(path.Base(r.URL.Path) + "/")
+		if err := fn(end, b); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,211 @@
+package igdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testWebhook = `
+{
+	"id": 42,
+	"url": "https://example.com/igdb/games",
+	"category": 1,
+	"sub_category": 2,
+	"active": true,
+	"number_of_retries": 0
+}
+`
+
+func TestClient_RegisterWebhook(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		resp        string
+		callbackURL string
+		secret      string
+		wantWebhook *Webhook
+		wantErr     error
+	}{
+		{
+			"Valid response",
+			http.StatusOK,
+			testWebhook,
+			"https://example.com/igdb/games",
+			"shh",
+			&Webhook{ID: 42, URL: "https://example.com/igdb/games", Category: 1, SubCategory: 2, Active: true},
+			nil,
+		},
+		{"Empty callback URL", http.StatusOK, testWebhook, "", "shh", nil, ErrEmptyWebhookArg},
+		{"Empty secret", http.StatusOK, testWebhook, "https://example.com/igdb/games", " ", nil, ErrEmptyWebhookArg},
+		{"Bad status", http.StatusBadRequest, "", "https://example.com/igdb/games", "shh", nil, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" {
+					t.Errorf("got method: <%v>, want: <%v>", r.Method, "POST")
+				}
+
+				if r.URL.Path != "/"+string(EndpointGame)+webhookPath {
+					t.Errorf("got path: <%v>, want: <%v>", r.URL.Path, "/"+string(EndpointGame)+webhookPath)
+				}
+
+				if ct := r.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+					t.Errorf("got content type: <%v>, want: <%v>", ct, "application/x-www-form-urlencoded")
+				}
+
+				if err := r.ParseForm(); err != nil {
+					t.Error(err)
+				}
+
+				want := map[string]string{"url": test.callbackURL, "method": "update", "secret": test.secret}
+				for k, v := range want {
+					if got := r.PostForm.Get(k); got != v {
+						t.Errorf("got form value %s: <%v>, want: <%v>", k, got, v)
+					}
+				}
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			wh, err := c.RegisterWebhook(EndpointGame, WebhookUpdate, test.callbackURL, test.secret)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(wh, test.wantWebhook) {
+				t.Errorf("got: <%v>, want: <%v>", wh, test.wantWebhook)
+			}
+		})
+	}
+}
+
+func TestClient_Webhooks(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		resp         string
+		wantWebhooks []*Webhook
+		wantErr      error
+	}{
+		{
+			"Valid response",
+			http.StatusOK,
+			"[" + testWebhook + "]",
+			[]*Webhook{{ID: 42, URL: "https://example.com/igdb/games", Category: 1, SubCategory: 2, Active: true}},
+			nil,
+		},
+		{"No results", http.StatusOK, "[]", nil, ErrNoResults},
+		{"Unauthorized", http.StatusUnauthorized, "", nil, ErrUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/"+webhookPath {
+					t.Errorf("got: <%v %v>, want: <GET %v>", r.Method, r.URL.Path, "/"+webhookPath)
+				}
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.resp))
+			})
+			defer ts.Close()
+
+			wh, err := c.Webhooks()
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(wh, test.wantWebhooks) {
+				t.Errorf("got: <%v>, want: <%v>", wh, test.wantWebhooks)
+			}
+		})
+	}
+}
+
+func TestClient_UnregisterWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		id      int
+		wantErr error
+	}{
+		{"Valid ID", http.StatusOK, 42, nil},
+		{"Negative ID", http.StatusOK, -42, ErrNegativeID},
+		{"Bad status", http.StatusBadRequest, 42, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "DELETE" || r.URL.Path != "/"+webhookPath+"42" {
+					t.Errorf("got: <%v %v>, want: <DELETE %v>", r.Method, r.URL.Path, "/"+webhookPath+"42")
+				}
+
+				w.WriteHeader(test.status)
+				w.Write([]byte(testWebhook))
+			})
+			defer ts.Close()
+
+			err := c.UnregisterWebhook(test.id)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		method     string
+		header     string
+		body       string
+		fnErr      error
+		wantStatus int
+		wantEnd    endpoint
+	}{
+		{"Valid notification", "shh", "POST", "shh", `{"id": 1}`, nil, http.StatusOK, EndpointGame},
+		{"Wrong secret", "shh", "POST", "nope", `{"id": 1}`, nil, http.StatusUnauthorized, ""},
+		{"Missing secret", "shh", "POST", "", `{"id": 1}`, nil, http.StatusUnauthorized, ""},
+		{"Empty handler secret", "", "POST", "", `{"id": 1}`, nil, http.StatusUnauthorized, ""},
+		{"Wrong method", "shh", "GET", "shh", "", nil, http.StatusMethodNotAllowed, ""},
+		{"Invalid payload", "shh", "POST", "shh", "{", nil, http.StatusBadRequest, ""},
+		{"Handler error", "shh", "POST", "shh", `{"id": 1}`, errors.New("failed"), http.StatusInternalServerError, EndpointGame},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var gotEnd endpoint
+			var gotBody []byte
+			h := WebhookHandler(test.secret, func(end endpoint, body []byte) error {
+				gotEnd = end
+				gotBody = body
+				return test.fnErr
+			})
+
+			req := httptest.NewRequest(test.method, "https://example.com/igdb/games", strings.NewReader(test.body))
+			req.Header.Set(headerSecret, test.header)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != test.wantStatus {
+				t.Errorf("got: <%v>, want: <%v>", rec.Code, test.wantStatus)
+			}
+
+			if gotEnd != test.wantEnd {
+				t.Errorf("got: <%v>, want: <%v>", gotEnd, test.wantEnd)
+			}
+
+			if test.wantEnd != "" && string(gotBody) != test.body {
+				t.Errorf("got: <%s>, want: <%s>", gotBody, test.body)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package igdb
 
 import (
+	"context"
 	"github.com/Henry-Sarabia/sliceconv"
 	"github.com/pkg/errors"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 type Website struct {
 	ID       int             `json:"id"`
 	Category WebsiteCategory `json:"category"`
+	Game     int             `json:"game"`
 	Trusted  bool            `json:"trusted"`
 	URL      string          `json:"url"`
 }
@@ -20,6 +22,8 @@ type Website struct {
 // WebsiteCategory specifies a specific popular website.
 type WebsiteCategory int
 
+//go:generate stringer -type=WebsiteCategory
+
 // Expected WebsiteCategory enums from the IGDB.
 const (
 	WebsiteOfficial WebsiteCategory = iota + 1
@@ -36,12 +40,10 @@ const (
 	WebsiteAndroid
 	WebsiteSteam
 	WebsiteReddit
+	WebsiteItch
+	WebsiteEpicGames
+	WebsiteGOG
 	WebsiteDiscord
-	WebsiteGooglePlus
-	WebsiteTumblr
-	WebsiteLinkedin
-	WebsitePinterest
-	WebsiteSoundcloud
 )
 
 // WebsiteService handles all the API calls for the IGDB Website endpoint.
@@ -51,14 +53,23 @@ type WebsiteService service
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Websites, an error is returned.
 func (ws *WebsiteService) Get(id int, opts ...Option) (*Website, error) {
+	return ws.GetWithContext(context.Background(), id, opts...)
+}
+
+// GetWithContext returns a single Website identified by the provided IGDB ID.
+// The request is canceled if the provided context is canceled or its deadline
+// is exceeded. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the ID does not match any Websites, an error is
+// returned.
+func (ws *WebsiteService) GetWithContext(ctx context.Context, id int, opts ...Option) (*Website, error) {
 	if id < 0 {
 		return nil, ErrNegativeID
 	}
 
 	var web []*Website
 
-	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
-	err := ws.client.post(ws.end, &web, opts...)
+	opts = append(requireResults(opts), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ws.client.postWithContext(ctx, ws.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Website with ID %v", id)
 	}
@@ -71,6 +82,15 @@ func (ws *WebsiteService) Get(id int, opts ...Option) (*Website, error) {
 // Any ID that does not match a Website is ignored. If none of the IDs
 // match a Website, an error is returned.
 func (ws *WebsiteService) List(ids []int, opts ...Option) ([]*Website, error) {
+	return ws.ListWithContext(context.Background(), ids, opts...)
+}
+
+// ListWithContext returns a list of Websites identified by the provided list of
+// IGDB IDs. The request is canceled if the provided context is canceled or its
+// deadline is exceeded. Provide functional options to sort, filter, and
+// paginate the results. Any ID that does not match a Website is ignored. If
+// none of the IDs match a Website, an error is returned.
+func (ws *WebsiteService) ListWithContext(ctx context.Context, ids []int, opts ...Option) ([]*Website, error) {
 	for len(ids) < 1 {
 		return nil, ErrEmptyIDs
 	}
@@ -84,7 +104,7 @@ func (ws *WebsiteService) List(ids []int, opts ...Option) ([]*Website, error) {
 	var web []*Website
 
 	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
-	err := ws.client.post(ws.end, &web, opts...)
+	err := ws.client.postWithContext(ctx, ws.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get Websites with IDs %v", ids)
 	}
@@ -92,13 +112,66 @@ func (ws *WebsiteService) List(ids []int, opts ...Option) ([]*Website, error) {
 	return web, nil
 }
 
+// ListByGame returns the list of Websites belonging to the Game identified by
+// the provided IGDB ID. Up to 500 Websites are retrieved unless a different
+// limit is provided. Provide functional options to sort, filter, and paginate
+// the results. If the Game has no Websites, an error is returned.
+func (ws *WebsiteService) ListByGame(gameID int, opts ...Option) ([]*Website, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var web []*Website
+
+	opts = withDefaults(opts, SetLimit(maxLimit))
+	opts = append(opts, SetFilter("game", OpEquals, strconv.Itoa(gameID)))
+	err := ws.client.post(ws.end, &web, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Websites with Game ID %v", gameID)
+	}
+
+	return web, nil
+}
+
+// ListByGameAndCategory returns the list of Websites of the provided
+// WebsiteCategory belonging to the Game identified by the provided IGDB ID.
+// Provide functional options to sort, filter, and paginate the results. If
+// the Game has no Websites of the provided category, an error is returned.
+func (ws *WebsiteService) ListByGameAndCategory(gameID int, cat WebsiteCategory, opts ...Option) ([]*Website, error) {
+	if gameID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var web []*Website
+
+	opts = append(opts,
+		SetFilter("game", OpEquals, strconv.Itoa(gameID)),
+		SetFilter("category", OpEquals, strconv.Itoa(int(cat))),
+	)
+	err := ws.client.post(ws.end, &web, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %v Websites with Game ID %v", cat, gameID)
+	}
+
+	return web, nil
+}
+
 // Index returns an index of Websites based solely on the provided functional
 // options used to sort, filter, and paginate the results. If no Websites can
 // be found using the provided options, an error is returned.
 func (ws *WebsiteService) Index(opts ...Option) ([]*Website, error) {
+	return ws.IndexWithContext(context.Background(), opts...)
+}
+
+// IndexWithContext returns an index of Websites based solely on the provided
+// functional options used to sort, filter, and paginate the results. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. If no Websites can be found using the provided options, an error is
+// returned.
+func (ws *WebsiteService) IndexWithContext(ctx context.Context, opts ...Option) ([]*Website, error) {
 	var web []*Website
 
-	err := ws.client.post(ws.end, &web, opts...)
+	err := ws.client.postWithContext(ctx, ws.end, &web, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get index of Websites")
 	}
@@ -110,7 +183,15 @@ func (ws *WebsiteService) Index(opts ...Option) ([]*Website, error) {
 // Provide the SetFilter functional option if you need to filter
 // which Websites to count.
 func (ws *WebsiteService) Count(opts ...Option) (int, error) {
-	ct, err := ws.client.getCount(ws.end, opts...)
+	return ws.CountWithContext(context.Background(), opts...)
+}
+
+// CountWithContext returns the number of Websites available in the IGDB. The
+// request is canceled if the provided context is canceled or its deadline is
+// exceeded. Provide the SetFilter functional option if you need to filter which
+// Websites to count.
+func (ws *WebsiteService) CountWithContext(ctx context.Context, opts ...Option) (int, error) {
+	ct, err := ws.client.getCountWithContext(ctx, ws.end, opts...)
 	if err != nil {
 		return 0, errors.Wrap(err, "cannot count Websites")
 	}
@@ -121,7 +202,14 @@ func (ws *WebsiteService) Count(opts ...Option) (int, error) {
 // Fields returns the up-to-date list of fields in an
 // IGDB Website object.
 func (ws *WebsiteService) Fields() ([]string, error) {
-	f, err := ws.client.getFields(ws.end)
+	return ws.FieldsWithContext(context.Background())
+}
+
+// FieldsWithContext returns the up-to-date list of fields in an IGDB Website
+// object. The request is canceled if the provided context is canceled or its
+// deadline is exceeded.
+func (ws *WebsiteService) FieldsWithContext(ctx context.Context) ([]string, error) {
+	f, err := ws.client.getFieldsWithContext(ctx, ws.end)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get Website fields")
 	}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,7 +50,7 @@ func TestWebsiteService_Get(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.Websites.Get(test.id, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -96,7 +97,7 @@ func TestWebsiteService_List(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.Websites.List(test.ids, test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -107,6 +108,156 @@ func TestWebsiteService_List(t *testing.T) {
 	}
 }
 
+func TestWebsiteService_ListByGame(t *testing.T) {
+	f, err := ioutil.ReadFile(testWebsiteList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Website, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name         string
+		file         string
+		id           int
+		opts         []Option
+		wantLim      string
+		wantWebsites []*Website
+		wantErr      error
+	}{
+		{"Valid response", testWebsiteList, 1942, nil, "limit 500;", init, nil},
+		{"Custom limit", testWebsiteList, 1942, []Option{SetLimit(5)}, "limit 5;", init, nil},
+		{"Invalid ID", testFileEmpty, -1, nil, "", nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1942, nil, "limit 500;", nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1942, []Option{SetOffset(-99999)}, "", nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, "limit 500;", nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			web, err := c.Websites.ListByGame(test.id, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(web, test.wantWebsites) {
+				t.Errorf("got: <%v>, \nwant: <%v>", web, test.wantWebsites)
+			}
+
+			if !strings.Contains(body, test.wantLim) {
+				t.Errorf("got: <%v>, want: <%v>", body, test.wantLim)
+			}
+		})
+	}
+}
+
+func TestWebsiteService_ListByGameAndCategory(t *testing.T) {
+	f, err := ioutil.ReadFile(testWebsiteGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Website, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name         string
+		file         string
+		id           int
+		cat          WebsiteCategory
+		opts         []Option
+		wantBody     []string
+		wantWebsites []*Website
+		wantErr      error
+	}{
+		{"Valid response", testWebsiteGet, 50750, WebsiteSteam, nil, []string{"game = 50750", "category = 13"}, init, nil},
+		{"Invalid ID", testFileEmpty, -1, WebsiteSteam, nil, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 50750, WebsiteSteam, nil, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 50750, WebsiteSteam, []Option{SetOffset(-99999)}, nil, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 50750, WebsiteGOG, nil, []string{"category = 17"}, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body string
+			ts, c := testServerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+
+				resp, err := ioutil.ReadFile(test.file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write(resp)
+			})
+			defer ts.Close()
+
+			web, err := c.Websites.ListByGameAndCategory(test.id, test.cat, test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(web, test.wantWebsites) {
+				t.Errorf("got: <%v>, \nwant: <%v>", web, test.wantWebsites)
+			}
+
+			for _, want := range test.wantBody {
+				if !strings.Contains(body, want) {
+					t.Errorf("got: <%v>, want: <%v>", body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWebsiteCategory_String(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cat     WebsiteCategory
+		wantStr string
+	}{
+		{"Official", WebsiteOfficial, "WebsiteOfficial"},
+		{"Instagram", WebsiteCategory(8), "WebsiteInstagram"},
+		{"Steam", WebsiteCategory(13), "WebsiteSteam"},
+		{"Epic Games", WebsiteCategory(16), "WebsiteEpicGames"},
+		{"Discord", WebsiteCategory(18), "WebsiteDiscord"},
+		{"Gap", WebsiteCategory(7), "WebsiteCategory(7)"},
+		{"Unknown", WebsiteCategory(100), "WebsiteCategory(100)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.cat.String() != test.wantStr {
+				t.Errorf("got: <%v>, want: <%v>", test.cat.String(), test.wantStr)
+			}
+		})
+	}
+}
+
 func TestWebsiteService_Index(t *testing.T) {
 	f, err := ioutil.ReadFile(testWebsiteList)
 	if err != nil {
@@ -140,7 +291,7 @@ func TestWebsiteService_Index(t *testing.T) {
 			defer ts.Close()
 
 			web, err := c.Websites.Index(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -171,7 +322,7 @@ func TestWebsiteService_Count(t *testing.T) {
 			defer ts.Close()
 
 			count, err := c.Websites.Count(test.opts...)
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
@@ -202,7 +353,7 @@ func TestWebsiteService_Fields(t *testing.T) {
 			defer ts.Close()
 
 			fields, err := c.Websites.Fields()
-			if errors.Cause(err) != test.wantErr {
+			if !errors.Is(err, test.wantErr) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 